@@ -0,0 +1,517 @@
+// Package config описывает настройки сервиса метаданных и API-шлюза.
+//
+// Порядок применения: значения по умолчанию, затем JSON-файл (если задан),
+// затем переменные окружения. Итоговая конфигурация проверяется Validate-
+// методами соответствующего раздела.
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvConfigPath — переменная окружения с путём к файлу конфигурации,
+// используется, если путь не передан флагом -config.
+const EnvConfigPath = "HIKARI_CONFIG"
+
+type Config struct {
+	Metadata MetadataConfig `json:"metadata"`
+	Gateway  GatewayConfig  `json:"gateway"`
+}
+
+type MetadataConfig struct {
+	// ListenAddr — адрес, на котором gRPC-сервер принимает соединения.
+	ListenAddr string `json:"listen_addr"`
+	// Provider — имя основного источника метаданных: "tmdb", "anilist"
+	// или "fake".
+	Provider string `json:"provider"`
+	// FallbackProvider — источник, к которому обращаются списки и поиск,
+	// если основной недоступен. Пустая строка отключает переключение.
+	FallbackProvider string            `json:"fallback_provider"`
+	TMDb             TMDbConfig        `json:"tmdb"`
+	AniList          AniListConfig     `json:"anilist"`
+	Cache            CacheConfig       `json:"cache"`
+	Catalog          CatalogConfig     `json:"catalog"`
+	HTTP             HTTPConfig        `json:"http"`
+	IDMapping        IDMapConfig       `json:"id_mapping"`
+	Language         LanguageConfig    `json:"language"`
+	Images           ImagesConfig      `json:"images"`
+	SearchIndex      SearchIndexConfig `json:"search_index"`
+}
+
+type TMDbConfig struct {
+	APIKey  string `json:"api_key"`
+	BaseURL string `json:"base_url"`
+}
+
+type AniListConfig struct {
+	BaseURL string `json:"base_url"`
+}
+
+// CacheConfig — настройки кэша ответов в памяти.
+type CacheConfig struct {
+	Enabled    bool  `json:"enabled"`
+	MaxEntries int   `json:"max_entries"`
+	MaxBytes   int64 `json:"max_bytes"`
+	// DefaultTTL применяется к методам, не перечисленным в TTL.
+	DefaultTTL Duration `json:"default_ttl"`
+	// TTL задаёт время жизни ответов по имени RPC; "0s" отключает кэширование метода.
+	// Значения по умолчанию действуют для методов, которых нет в файле;
+	// null убирает значение по умолчанию, и метод кэшируется на DefaultTTL.
+	TTL map[string]Duration `json:"ttl"`
+}
+
+// CatalogConfig — настройки постоянного каталога на диске.
+type CatalogConfig struct {
+	Enabled bool   `json:"enabled"`
+	Path    string `json:"path"`
+	// FreshFor — возраст, до которого ответ отдаётся из каталога без
+	// обращения к источнику.
+	FreshFor Duration `json:"fresh_for"`
+	// Retention — возраст, после которого записи удаляются.
+	Retention Duration `json:"retention"`
+	// EvictInterval — период фоновой очистки устаревших записей.
+	EvictInterval Duration `json:"evict_interval"`
+}
+
+// IDMapConfig — настройки соответствий ID между источниками.
+type IDMapConfig struct {
+	// Path — JSON-файл соответствий в формате anime-lists; пустая строка —
+	// без файла, соответствия накапливаются только из ответов источников.
+	Path string `json:"path"`
+}
+
+// LanguageConfig — настройки локализации ответов.
+type LanguageConfig struct {
+	// Fallback — языки, из которых по порядку берутся поля, не переведённые
+	// на язык запроса; "original" — язык оригинала тайтла. Пустой список
+	// отключает дополнение.
+	Fallback []string `json:"fallback"`
+}
+
+// ImagesConfig — настройки адресов изображений.
+type ImagesConfig struct {
+	// DefaultSizes — размеры, в которых строятся адреса, если клиент не
+	// выбрал свои: "original", ширина "w342" или высота "h632".
+	DefaultSizes []string `json:"default_sizes"`
+	// BaseURL заменяет базовый адрес изображений TMDb, например на
+	// прокси шлюза "http://localhost:8081/img/". Пустая строка — адрес из
+	// /configuration TMDb.
+	BaseURL      string             `json:"base_url"`
+	Placeholders PlaceholdersConfig `json:"placeholders"`
+}
+
+// PlaceholdersConfig — настройки фонового вычисления BlurHash и цветов
+// постеров.
+type PlaceholdersConfig struct {
+	Enabled bool `json:"enabled"`
+	// Workers — число одновременно обрабатываемых постеров.
+	Workers int `json:"workers"`
+	// MaxEntries — сколько результатов хранить в памяти.
+	MaxEntries int `json:"max_entries"`
+}
+
+// SearchIndexConfig — настройки локального поискового индекса по
+// названиям тайтлов из ответов источников.
+type SearchIndexConfig struct {
+	Enabled bool `json:"enabled"`
+	// MaxTitles — сколько тайтлов хранить в памяти; сверх этого числа
+	// вытесняются те, что дольше всех не встречались в ответах.
+	MaxTitles int `json:"max_titles"`
+}
+
+// HTTPConfig — настройки HTTP-клиента для обращений к внешним API.
+type HTTPConfig struct {
+	// Timeout ограничивает одну попытку запроса.
+	Timeout       Duration `json:"timeout"`
+	MaxRetries    int      `json:"max_retries"`
+	BackoffBase   Duration `json:"backoff_base"`
+	BackoffMax    Duration `json:"backoff_max"`
+	MaxConcurrent int      `json:"max_concurrent"`
+}
+
+type GatewayConfig struct {
+	ListenAddr string `json:"listen_addr"`
+	// MetadataServiceAddr — адрес gRPC-сервиса метаданных.
+	MetadataServiceAddr string           `json:"metadata_service_addr"`
+	CORS                CORSConfig       `json:"cors"`
+	ImageProxy          ImageProxyConfig `json:"image_proxy"`
+}
+
+// ImageProxyConfig — настройки прокси изображений /img/:size/*path.
+type ImageProxyConfig struct {
+	Enabled bool `json:"enabled"`
+	// UpstreamURL — базовый адрес изображений TMDb, к которому
+	// добавляются размер и путь.
+	UpstreamURL string `json:"upstream_url"`
+	// CacheDir — каталог дискового кэша изображений.
+	CacheDir string `json:"cache_dir"`
+	// MaxBytes ограничивает размер кэша; при превышении удаляются давно
+	// не запрашивавшиеся изображения.
+	MaxBytes int64    `json:"max_bytes"`
+	Timeout  Duration `json:"timeout"`
+	// ResizedSizes — размеры, которых нет у TMDb и которые прокси
+	// получает уменьшением, например "w200". Размеры TMDb разрешены
+	// всегда, прочие отклоняются с 400: каждый новый размер стоит
+	// декодирования оригинала и места в кэше.
+	ResizedSizes []string `json:"resized_sizes"`
+	// MaxConcurrentResizes ограничивает число одновременных уменьшений.
+	MaxConcurrentResizes int `json:"max_concurrent_resizes"`
+}
+
+type CORSConfig struct {
+	AllowOrigins []string `json:"allow_origins"`
+}
+
+// Default возвращает конфигурацию для локальной разработки. Ключ TMDb
+// намеренно пустой: его нужно передать через файл или TMDB_API_KEY.
+func Default() *Config {
+	return &Config{
+		Metadata: MetadataConfig{
+			ListenAddr: ":50051",
+			Provider:   "tmdb",
+			TMDb: TMDbConfig{
+				BaseURL: "https://api.themoviedb.org/3",
+			},
+			AniList: AniListConfig{
+				BaseURL: "https://graphql.anilist.co",
+			},
+			Cache: CacheConfig{
+				Enabled:    true,
+				MaxEntries: 10000,
+				MaxBytes:   64 << 20,
+				DefaultTTL: Duration(5 * time.Minute),
+				TTL: map[string]Duration{
+					"GetPopularMovies": Duration(10 * time.Minute),
+					"GetMovieByID":     Duration(time.Hour),
+					"GetSeasonalChart": Duration(6 * time.Hour),
+					"GetRelations":     Duration(time.Hour),
+				},
+			},
+			Catalog: CatalogConfig{
+				Path:          "hikari-catalog.db",
+				FreshFor:      Duration(30 * time.Minute),
+				Retention:     Duration(30 * 24 * time.Hour),
+				EvictInterval: Duration(time.Hour),
+			},
+			Language: LanguageConfig{
+				Fallback: []string{"en-US", "original"},
+			},
+			Images: ImagesConfig{
+				DefaultSizes: []string{"w185", "w342", "w780", "original"},
+				Placeholders: PlaceholdersConfig{
+					Enabled:    true,
+					Workers:    2,
+					MaxEntries: 50000,
+				},
+			},
+			SearchIndex: SearchIndexConfig{
+				Enabled:   true,
+				MaxTitles: 200000,
+			},
+			HTTP: HTTPConfig{
+				Timeout:       Duration(10 * time.Second),
+				MaxRetries:    3,
+				BackoffBase:   Duration(200 * time.Millisecond),
+				BackoffMax:    Duration(5 * time.Second),
+				MaxConcurrent: 16,
+			},
+		},
+		Gateway: GatewayConfig{
+			ListenAddr:          ":8081",
+			MetadataServiceAddr: "localhost:50051",
+			CORS: CORSConfig{
+				AllowOrigins: []string{"http://localhost:5173"},
+			},
+			ImageProxy: ImageProxyConfig{
+				Enabled:              true,
+				UpstreamURL:          "https://image.tmdb.org/t/p",
+				CacheDir:             "hikari-images",
+				MaxBytes:             1 << 30,
+				Timeout:              Duration(15 * time.Second),
+				ResizedSizes:         []string{"w100", "w200", "w400", "w600"},
+				MaxConcurrentResizes: 2,
+			},
+		},
+	}
+}
+
+// Load собирает конфигурацию из значений по умолчанию, файла path
+// (пустая строка — без файла) и переменных окружения.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		if err := cfg.loadFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.applyEnv(os.LookupEnv); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("не удалось открыть файл конфигурации: %w", err)
+	}
+
+	defaultTTL := c.Metadata.Cache.TTL
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(c); err != nil {
+		return fmt.Errorf("ошибка в файле конфигурации %s: %w", path, err)
+	}
+
+	// Время жизни по RPC разбирается отдельно: в общей структуре null не
+	// отличить от "0s".
+	var file struct {
+		Metadata struct {
+			Cache struct {
+				TTL map[string]*Duration `json:"ttl"`
+			} `json:"cache"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("ошибка в файле конфигурации %s: %w", path, err)
+	}
+	c.Metadata.Cache.TTL = mergeTTL(defaultTTL, file.Metadata.Cache.TTL)
+	return nil
+}
+
+// mergeTTL дополняет время жизни из файла значениями по умолчанию для
+// методов, которых в файле нет. Методы со значением null в результат не
+// попадают.
+func mergeTTL(defaults map[string]Duration, file map[string]*Duration) map[string]Duration {
+	merged := make(map[string]Duration, len(defaults)+len(file))
+	for rpc, ttl := range defaults {
+		if _, ok := file[rpc]; !ok {
+			merged[rpc] = ttl
+		}
+	}
+	for rpc, ttl := range file {
+		if ttl != nil {
+			merged[rpc] = *ttl
+		}
+	}
+	return merged
+}
+
+// envOverrides перечисляет поддерживаемые переменные окружения.
+var envOverrides = []struct {
+	name  string
+	apply func(c *Config, value string) error
+}{
+	{"HIKARI_METADATA_LISTEN_ADDR", func(c *Config, v string) error { c.Metadata.ListenAddr = v; return nil }},
+	{"HIKARI_METADATA_PROVIDER", func(c *Config, v string) error { c.Metadata.Provider = v; return nil }},
+	{"TMDB_API_KEY", func(c *Config, v string) error { c.Metadata.TMDb.APIKey = v; return nil }},
+	{"HIKARI_METADATA_FALLBACK_PROVIDER", func(c *Config, v string) error { c.Metadata.FallbackProvider = v; return nil }},
+	{"TMDB_BASE_URL", func(c *Config, v string) error { c.Metadata.TMDb.BaseURL = v; return nil }},
+	{"ANILIST_BASE_URL", func(c *Config, v string) error { c.Metadata.AniList.BaseURL = v; return nil }},
+	{"HIKARI_CACHE_ENABLED", func(c *Config, v string) (err error) { c.Metadata.Cache.Enabled, err = strconv.ParseBool(v); return }},
+	{"HIKARI_CACHE_MAX_ENTRIES", func(c *Config, v string) (err error) { c.Metadata.Cache.MaxEntries, err = strconv.Atoi(v); return }},
+	{"HIKARI_CATALOG_ENABLED", func(c *Config, v string) (err error) { c.Metadata.Catalog.Enabled, err = strconv.ParseBool(v); return }},
+	{"HIKARI_CATALOG_PATH", func(c *Config, v string) error { c.Metadata.Catalog.Path = v; return nil }},
+	{"HIKARI_ID_MAPPING_PATH", func(c *Config, v string) error { c.Metadata.IDMapping.Path = v; return nil }},
+	{"HIKARI_LANGUAGE_FALLBACK", func(c *Config, v string) error { c.Metadata.Language.Fallback = splitList(v); return nil }},
+	{"HIKARI_IMAGE_SIZES", func(c *Config, v string) error { c.Metadata.Images.DefaultSizes = splitList(v); return nil }},
+	{"HIKARI_IMAGE_BASE_URL", func(c *Config, v string) error { c.Metadata.Images.BaseURL = v; return nil }},
+	{"HIKARI_IMAGE_PLACEHOLDERS", func(c *Config, v string) (err error) {
+		c.Metadata.Images.Placeholders.Enabled, err = strconv.ParseBool(v)
+		return
+	}},
+	{"HIKARI_SEARCH_INDEX_ENABLED", func(c *Config, v string) (err error) {
+		c.Metadata.SearchIndex.Enabled, err = strconv.ParseBool(v)
+		return
+	}},
+	{"HIKARI_GATEWAY_LISTEN_ADDR", func(c *Config, v string) error { c.Gateway.ListenAddr = v; return nil }},
+	{"HIKARI_METADATA_SERVICE_ADDR", func(c *Config, v string) error { c.Gateway.MetadataServiceAddr = v; return nil }},
+	{"HIKARI_IMAGE_PROXY_ENABLED", func(c *Config, v string) (err error) {
+		c.Gateway.ImageProxy.Enabled, err = strconv.ParseBool(v)
+		return
+	}},
+	{"HIKARI_IMAGE_CACHE_DIR", func(c *Config, v string) error { c.Gateway.ImageProxy.CacheDir = v; return nil }},
+	{"HIKARI_CORS_ALLOW_ORIGINS", func(c *Config, v string) error { c.Gateway.CORS.AllowOrigins = splitList(v); return nil }},
+}
+
+func (c *Config) applyEnv(lookup func(string) (string, bool)) error {
+	for _, o := range envOverrides {
+		value, ok := lookup(o.name)
+		if !ok {
+			continue
+		}
+		if err := o.apply(c, value); err != nil {
+			return fmt.Errorf("некорректное значение %s: %w", o.name, err)
+		}
+	}
+	return nil
+}
+
+// languageTag — код языка ISO 639-1 с необязательным кодом региона.
+var languageTag = regexp.MustCompile(`^[a-z]{2}(-[A-Z]{2})?$`)
+
+// imageSize — размер изображения TMDb.
+var imageSize = regexp.MustCompile(`^(original|[wh][1-9][0-9]*)$`)
+
+// Validate проверяет настройки сервиса метаданных.
+func (c *MetadataConfig) Validate() error {
+	var errs []error
+	if c.ListenAddr == "" {
+		errs = append(errs, errors.New("metadata.listen_addr не задан"))
+	}
+
+	errs = append(errs, c.validateProvider("metadata.provider", c.Provider))
+	if c.FallbackProvider != "" {
+		if c.FallbackProvider == c.Provider {
+			errs = append(errs, errors.New("metadata.fallback_provider совпадает с metadata.provider"))
+		} else {
+			errs = append(errs, c.validateProvider("metadata.fallback_provider", c.FallbackProvider))
+		}
+	}
+
+	if c.Cache.MaxEntries < 0 || c.Cache.MaxBytes < 0 {
+		errs = append(errs, errors.New("metadata.cache: лимиты не могут быть отрицательными"))
+	}
+	for rpc, ttl := range c.Cache.TTL {
+		if ttl < 0 {
+			errs = append(errs, fmt.Errorf("metadata.cache.ttl.%s: отрицательное значение", rpc))
+		}
+	}
+
+	if c.HTTP.Timeout <= 0 {
+		errs = append(errs, errors.New("metadata.http.timeout должен быть положительным"))
+	}
+	if c.HTTP.MaxRetries < 0 || c.HTTP.MaxConcurrent < 0 {
+		errs = append(errs, errors.New("metadata.http: max_retries и max_concurrent не могут быть отрицательными"))
+	}
+	if c.HTTP.BackoffBase < 0 || c.HTTP.BackoffMax < c.HTTP.BackoffBase {
+		errs = append(errs, errors.New("metadata.http: нужно 0 <= backoff_base <= backoff_max"))
+	}
+
+	for _, language := range c.Language.Fallback {
+		if language != "original" && !languageTag.MatchString(language) {
+			errs = append(errs, fmt.Errorf("metadata.language.fallback: %q — не код языка вида \"en-US\" и не \"original\"", language))
+		}
+	}
+
+	if len(c.Images.DefaultSizes) == 0 {
+		errs = append(errs, errors.New("metadata.images.default_sizes не может быть пустым"))
+	}
+	for _, size := range c.Images.DefaultSizes {
+		if !imageSize.MatchString(size) {
+			errs = append(errs, fmt.Errorf("metadata.images.default_sizes: %q — не \"original\" и не размер вида \"w342\"", size))
+		}
+	}
+
+	if u := c.Images.BaseURL; u != "" && !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+		errs = append(errs, errors.New("metadata.images.base_url должен начинаться с http:// или https://"))
+	}
+	if p := c.Images.Placeholders; p.Enabled && (p.Workers <= 0 || p.MaxEntries <= 0) {
+		errs = append(errs, errors.New("metadata.images.placeholders: workers и max_entries должны быть положительными"))
+	}
+	if c.SearchIndex.Enabled && c.SearchIndex.MaxTitles <= 0 {
+		errs = append(errs, errors.New("metadata.search_index.max_titles должен быть положительным"))
+	}
+
+	if c.Catalog.Enabled {
+		if c.Catalog.Path == "" {
+			errs = append(errs, errors.New("metadata.catalog.path не задан"))
+		}
+		if c.Catalog.Retention <= 0 || c.Catalog.EvictInterval <= 0 {
+			errs = append(errs, errors.New("metadata.catalog: retention и evict_interval должны быть положительными"))
+		}
+		if c.Catalog.FreshFor > c.Catalog.Retention {
+			errs = append(errs, errors.New("metadata.catalog: fresh_for не может превышать retention"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *MetadataConfig) validateProvider(field, name string) error {
+	switch name {
+	case "tmdb":
+		if c.TMDb.APIKey == "" {
+			return fmt.Errorf("%s: metadata.tmdb.api_key не задан (можно передать через TMDB_API_KEY)", field)
+		}
+		if c.TMDb.BaseURL == "" {
+			return fmt.Errorf("%s: metadata.tmdb.base_url не задан", field)
+		}
+	case "anilist":
+		if c.AniList.BaseURL == "" {
+			return fmt.Errorf("%s: metadata.anilist.base_url не задан", field)
+		}
+	case "fake":
+	default:
+		return fmt.Errorf("%s: неизвестный источник %q", field, name)
+	}
+	return nil
+}
+
+// Validate проверяет настройки API-шлюза.
+func (c *GatewayConfig) Validate() error {
+	var errs []error
+	if c.ListenAddr == "" {
+		errs = append(errs, errors.New("gateway.listen_addr не задан"))
+	}
+	if c.MetadataServiceAddr == "" {
+		errs = append(errs, errors.New("gateway.metadata_service_addr не задан"))
+	}
+	for _, origin := range c.CORS.AllowOrigins {
+		if !strings.HasPrefix(origin, "http://") && !strings.HasPrefix(origin, "https://") {
+			errs = append(errs, fmt.Errorf("gateway.cors.allow_origins: %q должен начинаться с http:// или https://", origin))
+		}
+	}
+	if p := c.ImageProxy; p.Enabled {
+		if !strings.HasPrefix(p.UpstreamURL, "http://") && !strings.HasPrefix(p.UpstreamURL, "https://") {
+			errs = append(errs, errors.New("gateway.image_proxy.upstream_url должен начинаться с http:// или https://"))
+		}
+		if p.CacheDir == "" {
+			errs = append(errs, errors.New("gateway.image_proxy.cache_dir не задан"))
+		}
+		if p.MaxBytes <= 0 || p.Timeout <= 0 || p.MaxConcurrentResizes <= 0 {
+			errs = append(errs, errors.New("gateway.image_proxy: max_bytes, timeout и max_concurrent_resizes должны быть положительными"))
+		}
+		for _, size := range p.ResizedSizes {
+			if size == "original" || !imageSize.MatchString(size) {
+				errs = append(errs, fmt.Errorf("gateway.image_proxy.resized_sizes: %q — не размер вида \"w200\"", size))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Dump печатает итоговую конфигурацию в JSON. Секреты маскируются.
+func (c *Config) Dump(w io.Writer) error {
+	masked := *c
+	if masked.Metadata.TMDb.APIKey != "" {
+		masked.Metadata.TMDb.APIKey = "***"
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(masked)
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,219 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	path := writeConfig(t, `{
+		"metadata": {
+			"provider": "anilist",
+			"tmdb": {"api_key": "из файла"},
+			"cache": {
+				"max_entries": 100,
+				"ttl": {"GetMovieByID": "2h", "GetRelations": null, "SearchMovies": "0s"}
+			}
+		},
+		"gateway": {"listen_addr": ":9000"}
+	}`)
+	t.Setenv("TMDB_API_KEY", "из окружения")
+	t.Setenv("HIKARI_CACHE_MAX_ENTRIES", "200")
+	t.Setenv("HIKARI_CORS_ALLOW_ORIGINS", "https://a.example, ,https://b.example")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := cfg.Metadata
+	if m.Provider != "anilist" || m.ListenAddr != ":50051" || cfg.Gateway.ListenAddr != ":9000" {
+		t.Errorf("значения из файла и по умолчанию: provider %q, listen_addr %q, gateway %q", m.Provider, m.ListenAddr, cfg.Gateway.ListenAddr)
+	}
+	if m.TMDb.APIKey != "из окружения" || m.Cache.MaxEntries != 200 {
+		t.Errorf("окружение не переопределило файл: api_key %q, max_entries %d", m.TMDb.APIKey, m.Cache.MaxEntries)
+	}
+	if want := []string{"https://a.example", "https://b.example"}; !slices.Equal(cfg.Gateway.CORS.AllowOrigins, want) {
+		t.Errorf("allow_origins = %q, want %q", cfg.Gateway.CORS.AllowOrigins, want)
+	}
+
+	wantTTL := map[string]Duration{
+		"GetPopularMovies": Duration(10 * time.Minute),
+		"GetMovieByID":     Duration(2 * time.Hour),
+		"GetSeasonalChart": Duration(6 * time.Hour),
+		"SearchMovies":     0,
+	}
+	if !maps.Equal(m.Cache.TTL, wantTTL) {
+		t.Errorf("cache.ttl = %v, want %v", m.Cache.TTL, wantTTL)
+	}
+	if ttl := Default().Metadata.Cache.TTL; ttl["GetMovieByID"] != Duration(time.Hour) || len(ttl) != 4 {
+		t.Errorf("файл изменил значения по умолчанию: %v", ttl)
+	}
+}
+
+func TestLoadWithoutFile(t *testing.T) {
+	cfg, err := Load("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !maps.Equal(cfg.Metadata.Cache.TTL, Default().Metadata.Cache.TTL) {
+		t.Errorf("cache.ttl = %v, want значения по умолчанию", cfg.Metadata.Cache.TTL)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		env     map[string]string
+		wantErr string
+	}{
+		{name: "неизвестное поле", content: `{"metadata": {"provder": "tmdb"}}`, wantErr: "provder"},
+		{name: "длительность не строкой", content: `{"metadata": {"cache": {"default_ttl": 300}}}`, wantErr: "строкой"},
+		{name: "некорректная длительность", content: `{"metadata": {"cache": {"ttl": {"GetMovieByID": "1d"}}}}`, wantErr: `"1d"`},
+		{name: "некорректный JSON", content: `{"metadata": `, wantErr: "ошибка в файле конфигурации"},
+		{name: "некорректное окружение", content: `{}`, env: map[string]string{"HIKARI_CACHE_ENABLED": "да"}, wantErr: "HIKARI_CACHE_ENABLED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, value := range tt.env {
+				t.Setenv(name, value)
+			}
+			_, err := Load(writeConfig(t, tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("ошибка = %v, want содержащая %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if _, err := Load(filepath.Join(t.TempDir(), "нет.json")); err == nil {
+		t.Error("нет ошибки для отсутствующего файла")
+	}
+}
+
+func TestMetadataValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *MetadataConfig)
+		wantErr string
+	}{
+		{name: "корректная", change: func(c *MetadataConfig) {}},
+		{name: "без ключа TMDb", change: func(c *MetadataConfig) { c.TMDb.APIKey = "" }, wantErr: "metadata.tmdb.api_key"},
+		{name: "неизвестный источник", change: func(c *MetadataConfig) { c.Provider = "mal" }, wantErr: `неизвестный источник "mal"`},
+		{
+			name:    "запасной источник совпадает с основным",
+			change:  func(c *MetadataConfig) { c.FallbackProvider = "tmdb" },
+			wantErr: "совпадает",
+		},
+		{name: "отрицательное время жизни", change: func(c *MetadataConfig) { c.Cache.TTL["GetMovieByID"] = -1 }, wantErr: "metadata.cache.ttl.GetMovieByID"},
+		{name: "backoff_max меньше backoff_base", change: func(c *MetadataConfig) { c.HTTP.BackoffMax = 0 }, wantErr: "backoff_base"},
+		{name: "некорректный язык", change: func(c *MetadataConfig) { c.Language.Fallback = []string{"english"} }, wantErr: `"english"`},
+		{name: "некорректный размер", change: func(c *MetadataConfig) { c.Images.DefaultSizes = []string{"w0"} }, wantErr: `"w0"`},
+		{name: "search_index без лимита", change: func(c *MetadataConfig) { c.SearchIndex.MaxTitles = 0 }, wantErr: "max_titles"},
+		{
+			name:    "fresh_for больше retention",
+			change:  func(c *MetadataConfig) { c.Catalog.Enabled = true; c.Catalog.FreshFor = c.Catalog.Retention + 1 },
+			wantErr: "fresh_for",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default().Metadata
+			c.TMDb.APIKey = "key"
+			tt.change(&c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate = %v, want ошибку с %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGatewayValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		change  func(c *GatewayConfig)
+		wantErr string
+	}{
+		{name: "корректная", change: func(c *GatewayConfig) {}},
+		{name: "без адреса сервиса", change: func(c *GatewayConfig) { c.MetadataServiceAddr = "" }, wantErr: "metadata_service_addr"},
+		{name: "origin без схемы", change: func(c *GatewayConfig) { c.CORS.AllowOrigins = []string{"localhost"} }, wantErr: `"localhost"`},
+		{name: "размер TMDb для уменьшения", change: func(c *GatewayConfig) { c.ImageProxy.ResizedSizes = []string{"original"} }, wantErr: `"original"`},
+		{
+			name:   "выключенный прокси не проверяется",
+			change: func(c *GatewayConfig) { c.ImageProxy.Enabled = false; c.ImageProxy.CacheDir = "" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Default().Gateway
+			tt.change(&c)
+			err := c.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate = %v, want ошибку с %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDump(t *testing.T) {
+	cfg := Default()
+	cfg.Metadata.TMDb.APIKey = "секретный-ключ"
+
+	var buf bytes.Buffer
+	if err := cfg.Dump(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(buf.String(), "секретный-ключ") {
+		t.Errorf("ключ TMDb не замаскирован:\n%s", buf.String())
+	}
+	if cfg.Metadata.TMDb.APIKey != "секретный-ключ" {
+		t.Error("Dump изменил конфигурацию")
+	}
+
+	// Выведенная конфигурация загружается обратно.
+	var dumped Config
+	if err := json.Unmarshal(buf.Bytes(), &dumped); err != nil {
+		t.Fatal(err)
+	}
+	if dumped.Metadata.TMDb.APIKey != "***" || dumped.Metadata.Cache.DefaultTTL != cfg.Metadata.Cache.DefaultTTL {
+		t.Errorf("api_key %q, default_ttl %v", dumped.Metadata.TMDb.APIKey, dumped.Metadata.Cache.DefaultTTL)
+	}
+
+	cfg.Metadata.TMDb.APIKey = ""
+	buf.Reset()
+	if err := cfg.Dump(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"api_key": ""`) {
+		t.Errorf("пустой ключ замаскирован:\n%s", buf.String())
+	}
+}
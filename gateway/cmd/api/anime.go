@@ -0,0 +1,157 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// sortOrders — допустимые значения параметра sort.
+var sortOrders = map[string]pb.SortOrder{
+	"":           pb.SortOrder_SORT_ORDER_UNSPECIFIED,
+	"popularity": pb.SortOrder_SORT_ORDER_POPULARITY_DESC,
+	"rating":     pb.SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC,
+	"newest":     pb.SortOrder_SORT_ORDER_RELEASE_DATE_DESC,
+	"oldest":     pb.SortOrder_SORT_ORDER_RELEASE_DATE_ASC,
+	"title":      pb.SortOrder_SORT_ORDER_TITLE_ASC,
+}
+
+func popularAnimeHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, mediaType, ok := animeListParams(c)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetPopularAnime(ctx, &pb.GetPopularAnimeRequest{
+			Page:      page,
+			Language:  c.DefaultQuery("language", "ru-RU"),
+			MediaType: mediaType,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to fetch popular anime")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func discoverAnimeHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, mediaType, ok := animeListParams(c)
+		if !ok {
+			return
+		}
+
+		sortBy, ok := sortOrders[c.Query("sort")]
+		if !ok {
+			badRequest(c, "invalid sort parameter: expected popularity, rating, newest, oldest or title")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.DiscoverAnime(ctx, &pb.DiscoverAnimeRequest{
+			Page:      page,
+			Language:  c.DefaultQuery("language", "ru-RU"),
+			MediaType: mediaType,
+			SortBy:    sortBy,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to discover anime")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// seasonalChartHandler отдаёт аниме сезона:
+// GET /api/v1/anime/season/2024/spring?format=tv,ova&sort=rating.
+func seasonalChartHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		year, err := strconv.Atoi(c.Param("year"))
+		if err != nil {
+			badRequest(c, "invalid year parameter")
+			return
+		}
+
+		var season pb.AnimeSeason
+		if err := season.UnmarshalText([]byte(c.Param("season"))); err != nil || season == pb.AnimeSeason_ANIME_SEASON_UNSPECIFIED {
+			badRequest(c, "invalid season parameter: expected winter, spring, summer or fall")
+			return
+		}
+
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			badRequest(c, "invalid page parameter")
+			return
+		}
+
+		var formats []pb.TitleFormat
+		if list := c.Query("format"); list != "" {
+			for _, name := range strings.Split(list, ",") {
+				var format pb.TitleFormat
+				if err := format.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil || format == pb.TitleFormat_TITLE_FORMAT_UNSPECIFIED {
+					badRequest(c, "invalid format parameter: expected a comma-separated list of tv, tv_short, movie, special, ova, ona or music")
+					return
+				}
+				formats = append(formats, format)
+			}
+		}
+
+		sortBy, ok := sortOrders[c.Query("sort")]
+		if !ok {
+			badRequest(c, "invalid sort parameter: expected popularity, rating, newest, oldest or title")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 10*time.Second)
+		defer cancel()
+
+		response, err := client.GetSeasonalChart(ctx, &pb.GetSeasonalChartRequest{
+			Year:     int32(year),
+			Season:   season,
+			Page:     int32(page),
+			Language: c.DefaultQuery("language", "ru-RU"),
+			Formats:  formats,
+			SortBy:   sortBy,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to fetch seasonal chart")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// animeListParams разбирает общие параметры списков аниме: page и
+// type (movie, tv или all). При ошибке ответ уже отправлен и ok == false.
+func animeListParams(c *gin.Context) (page int32, mediaType pb.MediaType, ok bool) {
+	pageInt, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || pageInt < 1 {
+		badRequest(c, "invalid page parameter")
+		return 0, 0, false
+	}
+
+	switch c.DefaultQuery("type", "all") {
+	case "all":
+		mediaType = pb.MediaType_MEDIA_TYPE_UNSPECIFIED
+	case "movie":
+		mediaType = pb.MediaType_MEDIA_TYPE_MOVIE
+	case "tv":
+		mediaType = pb.MediaType_MEDIA_TYPE_TV
+	default:
+		badRequest(c, "invalid type parameter: expected movie, tv or all")
+		return 0, 0, false
+	}
+
+	return int32(pageInt), mediaType, true
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+var languageCode = regexp.MustCompile(`^[a-z]{2}$`)
+
+// discoverHandler подбирает фильмы или сериалы по фильтрам:
+// GET /api/v1/discover?type=tv&genres=16,10759&without_genres=10762&year_from=2010
+// &year_to=2020&min_rating=7.5&min_votes=100&original_language=ja&max_runtime=30&sort=rating.
+func discoverHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var mediaType pb.MediaType
+		if err := mediaType.UnmarshalText([]byte(c.Query("type"))); err != nil ||
+			(mediaType != pb.MediaType_MEDIA_TYPE_MOVIE && mediaType != pb.MediaType_MEDIA_TYPE_TV) {
+			badRequest(c, "invalid type parameter: expected movie or tv")
+			return
+		}
+
+		req := &pb.DiscoverRequest{
+			MediaType:        mediaType,
+			Language:         c.DefaultQuery("language", "ru-RU"),
+			OriginalLanguage: c.Query("original_language"),
+		}
+
+		var ok bool
+		if req.SortBy, ok = sortOrders[c.Query("sort")]; !ok {
+			badRequest(c, "invalid sort parameter: expected popularity, rating, newest, oldest or title")
+			return
+		}
+		for _, p := range []struct {
+			name string
+			dst  *int32
+		}{
+			{"page", &req.Page},
+			{"year_from", &req.YearFrom},
+			{"year_to", &req.YearTo},
+			{"min_votes", &req.MinVotes},
+			{"min_runtime", &req.MinRuntime},
+			{"max_runtime", &req.MaxRuntime},
+		} {
+			if *p.dst, ok = nonNegativeParam(c, p.name); !ok {
+				return
+			}
+		}
+		if req.WithGenres, ok = genresParam(c, "genres"); !ok {
+			return
+		}
+		if req.WithoutGenres, ok = genresParam(c, "without_genres"); !ok {
+			return
+		}
+		if raw := c.Query("min_rating"); raw != "" {
+			rating, err := strconv.ParseFloat(raw, 64)
+			if err != nil || rating < 0 || rating > 10 {
+				badRequest(c, "invalid min_rating parameter: expected a number from 0 to 10")
+				return
+			}
+			req.MinRating = rating
+		}
+
+		switch {
+		case req.OriginalLanguage != "" && !languageCode.MatchString(req.OriginalLanguage):
+			badRequest(c, "invalid original_language parameter: expected an ISO 639-1 code, e.g. ja")
+			return
+		case req.YearFrom != 0 && req.YearTo != 0 && req.YearFrom > req.YearTo:
+			badRequest(c, "year_from must not be greater than year_to")
+			return
+		case req.MaxRuntime != 0 && req.MinRuntime > req.MaxRuntime:
+			badRequest(c, "min_runtime must not be greater than max_runtime")
+			return
+		}
+		for _, g := range req.WithGenres {
+			if slices.Contains(req.WithoutGenres, g) {
+				badRequest(c, fmt.Sprintf("genre %d is both in genres and without_genres", g))
+				return
+			}
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.Discover(ctx, req)
+		if err != nil {
+			grpcError(c, err, "failed to discover titles")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// nonNegativeParam разбирает необязательный целый параметр name; пустой
+// параметр — 0. При ошибке отвечает 400.
+func nonNegativeParam(c *gin.Context, name string) (int32, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return 0, true
+	}
+	n, err := strconv.ParseInt(raw, 10, 32)
+	if err != nil || n < 0 {
+		badRequest(c, fmt.Sprintf("invalid %s parameter: expected a non-negative integer", name))
+		return 0, false
+	}
+	return int32(n), true
+}
+
+// genresParam разбирает список ID жанров через запятую. При ошибке
+// отвечает 400.
+func genresParam(c *gin.Context, name string) ([]int32, bool) {
+	raw := c.Query(name)
+	if raw == "" {
+		return nil, true
+	}
+	var genres []int32
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 32)
+		if err != nil || id <= 0 {
+			badRequest(c, fmt.Sprintf("invalid %s parameter: expected a comma-separated list of genre IDs", name))
+			return nil, false
+		}
+		genres = append(genres, int32(id))
+	}
+	return genres, true
+}
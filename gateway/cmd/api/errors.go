@@ -0,0 +1,111 @@
+package main
+
+import (
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorBody — структура ответа шлюза при ошибке.
+type errorBody struct {
+	Error errorInfo `json:"error"`
+}
+
+type errorInfo struct {
+	// Status повторяет HTTP-код ответа.
+	Status int `json:"status"`
+	// Code — gRPC-код в нижнем регистре, например "not_found".
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Reason — причина из google.rpc.ErrorInfo, например "UPSTREAM_RATE_LIMITED".
+	Reason   string            `json:"reason,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// badRequest отвечает 400 с сообщением о некорректном параметре запроса.
+func badRequest(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, errorBody{Error: errorInfo{
+		Status:  http.StatusBadRequest,
+		Code:    "invalid_argument",
+		Message: message,
+	}})
+}
+
+// grpcError отвечает HTTP-ошибкой, соответствующей gRPC-статусу err.
+// message описывает неудавшееся действие, например "failed to get movie by ID".
+func grpcError(c *gin.Context, err error, message string) {
+	st := status.Convert(err)
+	httpStatus := httpStatusFromCode(st.Code())
+
+	info := errorInfo{
+		Status:  httpStatus,
+		Code:    codeName(st.Code()),
+		Message: message,
+	}
+	if st.Code() == codes.InvalidArgument {
+		info.Message = st.Message()
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info.Reason = d.GetReason()
+			info.Metadata = d.GetMetadata()
+		case *errdetails.RetryInfo:
+			seconds := math.Ceil(d.GetRetryDelay().AsDuration().Seconds())
+			c.Header("Retry-After", strconv.Itoa(int(seconds)))
+		}
+	}
+
+	if httpStatus >= http.StatusInternalServerError {
+		log.Printf("%s: %v", message, err)
+	}
+	c.JSON(httpStatus, errorBody{Error: info})
+}
+
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.Unauthenticated, codes.Unavailable, codes.Internal:
+		// Ошибки источника или сервиса метаданных, а не клиента шлюза.
+		return http.StatusBadGateway
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Canceled:
+		return 499
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// codeName возвращает имя кода в нижнем регистре через подчёркивание:
+// not_found, deadline_exceeded и т.д.
+func codeName(code codes.Code) string {
+	name := code.String()
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && unicode.IsLower(rune(name[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
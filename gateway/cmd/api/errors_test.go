@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+		name string
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest, "invalid_argument"},
+		{codes.OutOfRange, http.StatusBadRequest, "out_of_range"},
+		{codes.NotFound, http.StatusNotFound, "not_found"},
+		{codes.ResourceExhausted, http.StatusTooManyRequests, "resource_exhausted"},
+		{codes.DeadlineExceeded, http.StatusGatewayTimeout, "deadline_exceeded"},
+		{codes.Unauthenticated, http.StatusBadGateway, "unauthenticated"},
+		{codes.Unavailable, http.StatusBadGateway, "unavailable"},
+		{codes.Internal, http.StatusBadGateway, "internal"},
+		{codes.Unimplemented, http.StatusNotImplemented, "unimplemented"},
+		{codes.Canceled, 499, "canceled"},
+		{codes.Unknown, http.StatusInternalServerError, "unknown"},
+		{codes.FailedPrecondition, http.StatusInternalServerError, "failed_precondition"},
+		{codes.DataLoss, http.StatusInternalServerError, "data_loss"},
+		{codes.OK, http.StatusInternalServerError, "ok"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := httpStatusFromCode(tt.code); got != tt.want {
+				t.Errorf("httpStatusFromCode(%v) = %d, want %d", tt.code, got, tt.want)
+			}
+			if got := codeName(tt.code); got != tt.name {
+				t.Errorf("codeName(%v) = %q, want %q", tt.code, got, tt.name)
+			}
+		})
+	}
+}
+
+func TestGRPCError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	limited, err := status.New(codes.ResourceExhausted, "tmdb: API вернул ошибку: 429").WithDetails(
+		&errdetails.ErrorInfo{Reason: "UPSTREAM_RATE_LIMITED", Domain: "metadata.hikari", Metadata: map[string]string{"provider": "tmdb"}},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(1500 * time.Millisecond)},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantBody   errorInfo
+		wantRetry  string
+	}{
+		{
+			name:       "rate limit with retry info",
+			err:        limited.Err(),
+			wantStatus: http.StatusTooManyRequests,
+			wantBody: errorInfo{
+				Status: http.StatusTooManyRequests, Code: "resource_exhausted", Message: "failed to search",
+				Reason: "UPSTREAM_RATE_LIMITED", Metadata: map[string]string{"provider": "tmdb"},
+			},
+			wantRetry: "2",
+		},
+		{
+			name:       "invalid argument keeps the service message",
+			err:        status.Error(codes.InvalidArgument, "query is required"),
+			wantStatus: http.StatusBadRequest,
+			wantBody:   errorInfo{Status: http.StatusBadRequest, Code: "invalid_argument", Message: "query is required"},
+		},
+		{
+			name:       "non-status error",
+			err:        errors.New("connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantBody:   errorInfo{Status: http.StatusInternalServerError, Code: "unknown", Message: "failed to search"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			grpcError(c, tt.err, "failed to search")
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", w.Code, tt.wantStatus)
+			}
+			if got := w.Header().Get("Retry-After"); got != tt.wantRetry {
+				t.Errorf("Retry-After = %q, want %q", got, tt.wantRetry)
+			}
+			var body errorBody
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatal(err)
+			}
+			got := body.Error
+			if got.Status != tt.wantBody.Status || got.Code != tt.wantBody.Code || got.Message != tt.wantBody.Message ||
+				got.Reason != tt.wantBody.Reason || got.Metadata["provider"] != tt.wantBody.Metadata["provider"] {
+				t.Errorf("body = %+v, want %+v", got, tt.wantBody)
+			}
+		})
+	}
+}
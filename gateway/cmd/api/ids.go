@@ -0,0 +1,69 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// resolveIDsHandler отдаёт ID тайтла во всех известных источниках:
+// GET /api/v1/ids/anilist:21?type=tv.
+func resolveIDsHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.Contains(c.Param("id"), ":") {
+			badRequest(c, "invalid ID parameter: expected <source>:<id>, e.g. anilist:21")
+			return
+		}
+
+		var mediaType pb.MediaType
+		if t := c.Query("type"); t != "" {
+			if err := mediaType.UnmarshalText([]byte(t)); err != nil || mediaType == pb.MediaType_MEDIA_TYPE_PERSON {
+				badRequest(c, "invalid type parameter: expected movie or tv")
+				return
+			}
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.ResolveIDs(ctx, &pb.ResolveIDsRequest{
+			Id:        c.Param("id"),
+			MediaType: mediaType,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to resolve IDs")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// titleID разбирает ID тайтла из пути: число — ID текущего источника,
+// "anilist:21" — ID с пространством имён.
+func titleID(raw string) (id int64, namespaced string, ok bool) {
+	if strings.Contains(raw, ":") {
+		return 0, raw, true
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	return id, "", err == nil
+}
+
+// titleParams разбирает ID тайтла из пути и обязательный параметр
+// type=movie|tv. При ошибке отвечает 400 и возвращает ok == false.
+func titleParams(c *gin.Context) (id int64, namespaced string, mediaType pb.MediaType, ok bool) {
+	id, namespaced, ok = titleID(c.Param("id"))
+	if !ok {
+		badRequest(c, "invalid title ID parameter")
+		return 0, "", 0, false
+	}
+	if err := mediaType.UnmarshalText([]byte(c.Query("type"))); err != nil ||
+		(mediaType != pb.MediaType_MEDIA_TYPE_MOVIE && mediaType != pb.MediaType_MEDIA_TYPE_TV) {
+		badRequest(c, "invalid type parameter: expected movie or tv")
+		return 0, "", 0, false
+	}
+	return id, namespaced, mediaType, true
+}
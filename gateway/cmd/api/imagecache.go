@@ -0,0 +1,154 @@
+package main
+
+import (
+	"container/list"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// diskCache — кэш изображений в каталоге на диске с вытеснением давно не
+// запрашивавшихся файлов, когда суммарный размер превышает maxBytes.
+// Время последнего обращения хранится во времени изменения файла, поэтому
+// порядок вытеснения переживает перезапуск.
+type diskCache struct {
+	dir      string
+	maxBytes int64
+
+	mu      sync.Mutex
+	lru     *list.List // *diskEntry, недавние спереди
+	entries map[string]*list.Element
+	size    int64
+}
+
+type diskEntry struct {
+	name string
+	size int64
+}
+
+// tempSuffix — суффикс файлов, которые ещё записываются.
+const tempSuffix = ".tmp"
+
+// openDiskCache открывает кэш в каталоге dir, создавая его при
+// необходимости, и учитывает уже сохранённые файлы.
+func openDiskCache(dir string, maxBytes int64) (*diskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create image cache dir: %w", err)
+	}
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read image cache dir: %w", err)
+	}
+
+	var files []os.FileInfo
+	for _, e := range dirEntries {
+		if e.IsDir() {
+			continue
+		}
+		if strings.HasSuffix(e.Name(), tempSuffix) {
+			os.Remove(filepath.Join(dir, e.Name()))
+			continue
+		}
+		if info, err := e.Info(); err == nil {
+			files = append(files, info)
+		}
+	}
+	slices.SortFunc(files, func(a, b os.FileInfo) int {
+		return b.ModTime().Compare(a.ModTime())
+	})
+
+	d := &diskCache{
+		dir:      dir,
+		maxBytes: maxBytes,
+		lru:      list.New(),
+		entries:  make(map[string]*list.Element, len(files)),
+	}
+	for _, f := range files {
+		d.entries[f.Name()] = d.lru.PushBack(&diskEntry{name: f.Name(), size: f.Size()})
+		d.size += f.Size()
+	}
+	d.mu.Lock()
+	d.evict()
+	d.mu.Unlock()
+
+	log.Printf("Image cache: %d files, %d bytes in %s", len(d.entries), d.size, dir)
+	return d, nil
+}
+
+// open возвращает сохранённый файл name и отмечает обращение к нему.
+// Если файла нет, ошибка удовлетворяет errors.Is(err, os.ErrNotExist).
+func (d *diskCache) open(name string) (*os.File, error) {
+	d.mu.Lock()
+	el, ok := d.entries[name]
+	if ok {
+		d.lru.MoveToFront(el)
+	}
+	d.mu.Unlock()
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+
+	path := filepath.Join(d.dir, name)
+	now := time.Now()
+	os.Chtimes(path, now, now)
+	return os.Open(path)
+}
+
+// put сохраняет data под именем name и вытесняет старые файлы, если кэш
+// переполнен.
+func (d *diskCache) put(name string, data []byte) error {
+	path := filepath.Join(d.dir, name)
+	tmp, err := os.CreateTemp(d.dir, name+"-*"+tempSuffix)
+	if err != nil {
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if el, ok := d.entries[name]; ok {
+		d.size -= el.Value.(*diskEntry).size
+		d.lru.Remove(el)
+	}
+	d.entries[name] = d.lru.PushFront(&diskEntry{name: name, size: int64(len(data))})
+	d.size += int64(len(data))
+	d.evict()
+	return nil
+}
+
+// evict удаляет давно не запрашивавшиеся файлы, пока кэш переполнен.
+// Вызывается под d.mu.
+func (d *diskCache) evict() {
+	for d.size > d.maxBytes && d.lru.Len() > 0 {
+		el := d.lru.Back()
+		e := el.Value.(*diskEntry)
+		d.lru.Remove(el)
+		delete(d.entries, e.name)
+		d.size -= e.size
+		if err := os.Remove(filepath.Join(d.dir, e.name)); err != nil && !os.IsNotExist(err) {
+			log.Printf("failed to evict cached image %s: %v", e.name, err)
+		}
+	}
+}
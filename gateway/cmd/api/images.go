@@ -0,0 +1,293 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/waste3d/Hikari-Anime/config"
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	"github.com/waste3d/Hikari-Anime/metadata/images"
+	"golang.org/x/sync/singleflight"
+)
+
+// imagePath — путь изображения TMDb, например "/abc.jpg".
+var imagePath = regexp.MustCompile(`^/[A-Za-z0-9_-]+\.(jpg|jpeg|png)$`)
+
+const (
+	// maxImageSide ограничивает ширину и высоту изображений, которые
+	// прокси готов декодировать для уменьшения.
+	maxImageSide = 8000
+	// jpegQuality — качество уменьшенных JPEG.
+	jpegQuality = 85
+)
+
+// imageProxy отдаёт изображения TMDb через дисковый кэш. Размеры из
+// resizedSizes, которых нет у TMDb, получаются уменьшением ближайшего
+// большего размера. Формат при этом сохраняется: кодировщика WebP в
+// стандартной библиотеке нет.
+type imageProxy struct {
+	upstreamURL string
+	client      *httpclient.Client
+	cache       *diskCache
+	inflight    singleflight.Group
+	// upstreamSizes — размеры, которые TMDb отдаёт сам.
+	upstreamSizes []string
+	// resizedSizes — разрешённые размеры, которые получаются уменьшением.
+	resizedSizes []string
+	// resizes ограничивает число одновременных уменьшений: каждое
+	// декодирует изображение целиком.
+	resizes chan struct{}
+}
+
+func newImageProxy(cfg config.ImageProxyConfig) (*imageProxy, error) {
+	cache, err := openDiskCache(cfg.CacheDir, cfg.MaxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var sizes []string
+	for _, kindSizes := range images.DefaultConfig.Sizes {
+		for _, s := range kindSizes {
+			if !slices.Contains(sizes, s) {
+				sizes = append(sizes, s)
+			}
+		}
+	}
+
+	return &imageProxy{
+		upstreamURL: cfg.UpstreamURL,
+		client: httpclient.New(httpclient.Config{
+			Timeout:       time.Duration(cfg.Timeout),
+			MaxRetries:    2,
+			BackoffBase:   200 * time.Millisecond,
+			BackoffMax:    2 * time.Second,
+			MaxConcurrent: 8,
+		}),
+		cache:         cache,
+		upstreamSizes: sizes,
+		resizedSizes:  cfg.ResizedSizes,
+		resizes:       make(chan struct{}, cfg.MaxConcurrentResizes),
+	}, nil
+}
+
+// imageHandler отдаёт изображение в размере size: GET /img/w342/abc.jpg.
+// Адреса изображений неизменны, поэтому ответы кэшируются клиентом на год.
+func imageHandler(proxy *imageProxy) func(c *gin.Context) {
+	return func(c *gin.Context) {
+		size, path := c.Param("size"), c.Param("path")
+		if !slices.Contains(proxy.upstreamSizes, size) && !slices.Contains(proxy.resizedSizes, size) {
+			badRequest(c, "unsupported image size, allowed: "+strings.Join(proxy.allowedSizes(), ", "))
+			return
+		}
+		if !imagePath.MatchString(path) {
+			badRequest(c, "invalid image path")
+			return
+		}
+
+		name, err := proxy.load(c.Request.Context(), size, path)
+		if err != nil {
+			imageError(c, err)
+			return
+		}
+		f, err := proxy.cache.open(name)
+		if err != nil {
+			imageError(c, err)
+			return
+		}
+		defer f.Close()
+		info, err := f.Stat()
+		if err != nil {
+			imageError(c, err)
+			return
+		}
+
+		c.Header("Cache-Control", "public, max-age=31536000, immutable")
+		c.Header("ETag", `"`+name[:32]+`"`)
+		c.Header("Content-Type", mime.TypeByExtension(filepath.Ext(path)))
+		http.ServeContent(c.Writer, c.Request, name, info.ModTime(), f)
+	}
+}
+
+// allowedSizes возвращает все размеры, которые отдаёт прокси.
+func (p *imageProxy) allowedSizes() []string {
+	sizes := slices.Concat(p.upstreamSizes, p.resizedSizes)
+	slices.Sort(sizes)
+	return slices.Compact(sizes)
+}
+
+// imageError отвечает 404, если изображения нет у TMDb, и 502 при прочих
+// ошибках.
+func imageError(c *gin.Context, err error) {
+	var statusErr *httpclient.StatusError
+	if errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusNotFound {
+		c.JSON(http.StatusNotFound, errorBody{Error: errorInfo{
+			Status:  http.StatusNotFound,
+			Code:    "not_found",
+			Message: "image not found",
+		}})
+		return
+	}
+
+	log.Printf("failed to load image: %v", err)
+	c.JSON(http.StatusBadGateway, errorBody{Error: errorInfo{
+		Status:  http.StatusBadGateway,
+		Code:    "unavailable",
+		Message: "failed to load image",
+	}})
+}
+
+// cacheName возвращает имя файла кэша для изображения path в размере size.
+func cacheName(size, path string) string {
+	sum := sha256.Sum256([]byte(size + path))
+	return hex.EncodeToString(sum[:]) + filepath.Ext(path)
+}
+
+// load гарантирует, что изображение есть в кэше, и возвращает имя его
+// файла. Одновременные запросы одного изображения объединяются.
+func (p *imageProxy) load(ctx context.Context, size, path string) (string, error) {
+	name := cacheName(size, path)
+	if f, err := p.cache.open(name); err == nil {
+		f.Close()
+		return name, nil
+	}
+
+	_, err, _ := p.inflight.Do(name, func() (any, error) {
+		// Запрос может быть отменён клиентом, а результат нужен всем
+		// ожидающим: загружаем без его отмены.
+		ctx := context.WithoutCancel(ctx)
+
+		var data []byte
+		var err error
+		if slices.Contains(p.upstreamSizes, size) {
+			data, err = p.client.Get(ctx, p.upstreamURL+"/"+size+path)
+		} else {
+			data, err = p.resized(ctx, size, path)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return nil, p.cache.put(name, data)
+	})
+	return name, err
+}
+
+// resized возвращает изображение, уменьшенное до размера size из
+// ближайшего большего размера TMDb.
+func (p *imageProxy) resized(ctx context.Context, size, path string) ([]byte, error) {
+	side, n := size[0], mustAtoi(size[1:])
+	source := images.Original
+	best := 0
+	for _, s := range p.upstreamSizes {
+		if s == images.Original || s[0] != side {
+			continue
+		}
+		if sN := mustAtoi(s[1:]); sN >= n && (best == 0 || sN < best) {
+			source, best = s, sN
+		}
+	}
+
+	sourceName, err := p.load(ctx, source, path)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(filepath.Join(p.cache.dir, sourceName))
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	if cfg.Width > maxImageSide || cfg.Height > maxImageSide {
+		return nil, fmt.Errorf("image %s is too large to resize: %dx%d", path, cfg.Width, cfg.Height)
+	}
+	width, height := cfg.Width*n/cfg.Height, n
+	if side == 'w' {
+		width, height = n, cfg.Height*n/cfg.Width
+	}
+	if width >= cfg.Width || width < 1 || height < 1 {
+		return data, nil
+	}
+
+	p.resizes <- struct{}{}
+	defer func() { <-p.resizes }()
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+	dst := resize(src, width, height)
+
+	var buf bytes.Buffer
+	if format == "png" {
+		err = png.Encode(&buf, dst)
+	} else {
+		err = jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resize уменьшает src до width×height усреднением пикселей, которые
+// попадают в каждый пиксель результата.
+func resize(src image.Image, width, height int) *image.RGBA {
+	b := src.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), src, b.Min, draw.Src)
+	sw, sh := b.Dx(), b.Dy()
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		y0, y1 := y*sh/height, max((y+1)*sh/height, y*sh/height+1)
+		for x := range width {
+			x0, x1 := x*sw/width, max((x+1)*sw/width, x*sw/width+1)
+			var r, g, bl, a, count int
+			for sy := y0; sy < y1; sy++ {
+				row := rgba.Pix[sy*rgba.Stride:]
+				for sx := x0; sx < x1; sx++ {
+					px := row[sx*4 : sx*4+4]
+					r += int(px[0])
+					g += int(px[1])
+					bl += int(px[2])
+					a += int(px[3])
+					count++
+				}
+			}
+			i := y*dst.Stride + x*4
+			dst.Pix[i] = uint8(r / count)
+			dst.Pix[i+1] = uint8(g / count)
+			dst.Pix[i+2] = uint8(bl / count)
+			dst.Pix[i+3] = uint8(a / count)
+		}
+	}
+	return dst
+}
+
+// mustAtoi разбирает число из размера, уже проверенного при загрузке
+// конфигурации.
+func mustAtoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
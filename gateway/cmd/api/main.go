@@ -1,25 +1,41 @@
 package main
 
 import (
-	"context"
+	"flag"
 	"log"
 	"net/http"
+	"os"
 	"strconv"
 	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
+	"github.com/waste3d/Hikari-Anime/config"
 	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
 	"google.golang.org/grpc"
 )
 
-const (
-	gatewayPort            = ":8081"
-	metadataServiceAddress = "localhost:50051"
-)
-
 func main() {
-	grpcServer, err := grpc.Dial(metadataServiceAddress, grpc.WithInsecure())
+	configPath := flag.String("config", os.Getenv(config.EnvConfigPath), "path to JSON config file")
+	printConfig := flag.Bool("print-config", false, "print effective config and exit")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+	if *printConfig {
+		if err := cfg.Dump(os.Stdout); err != nil {
+			log.Fatalf("failed to print config: %v", err)
+		}
+		return
+	}
+	if err := cfg.Gateway.Validate(); err != nil {
+		log.Fatalf("invalid config: %v", err)
+	}
+
+	grpcServer, err := grpc.Dial(cfg.Gateway.MetadataServiceAddr, grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(forwardProvider))
 	if err != nil {
 		log.Fatalf("failed to connect to metadata service: %v", err)
 	}
@@ -30,9 +46,10 @@ func main() {
 	router := gin.Default()
 
 	router.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"http://localhost:5173"},
+		AllowOrigins:     cfg.Gateway.CORS.AllowOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE"},
 		AllowHeaders:     []string{"Content-Type"},
+		ExposeHeaders:    []string{providerHeader},
 		AllowCredentials: true,
 		MaxAge:           12 * time.Hour,
 	}))
@@ -41,13 +58,41 @@ func main() {
 		c.JSON(http.StatusOK, gin.H{"message": "pong"})
 	})
 
+	router.GET("/api/v1/search", multiSearchHandler(metadataServiceClient))
+	router.GET("/api/v1/discover", discoverHandler(metadataServiceClient))
+	router.GET("/api/v1/trending", trendingHandler(metadataServiceClient))
 	router.GET("/api/v1/movies/popular", getPopularMoviesHandler(metadataServiceClient))
 	router.GET("/api/v1/movies/search", searchMoviesHandler(metadataServiceClient))
 	router.GET("/api/v1/movies/:id", movieByIDHandler(metadataServiceClient))
+	router.GET("/api/v1/anime/popular", popularAnimeHandler(metadataServiceClient))
+	router.GET("/api/v1/anime/discover", discoverAnimeHandler(metadataServiceClient))
+	router.GET("/api/v1/anime/season/:year/:season", seasonalChartHandler(metadataServiceClient))
+	router.GET("/api/v1/anime/schedule", scheduleHandler(metadataServiceClient))
+	router.GET("/api/v1/anime/schedule.ics", scheduleICSHandler(metadataServiceClient))
 	router.GET("/api/v1/tv/search", searchTVShowsHandler(metadataServiceClient))
+	router.GET("/api/v1/tv/:id", tvShowByIDHandler(metadataServiceClient))
+	router.GET("/api/v1/tv/:id/season/:n", seasonHandler(metadataServiceClient))
+	router.GET("/api/v1/tv/:id/season/:n/episode/:e", episodeHandler(metadataServiceClient))
+	router.GET("/api/v1/titles/:id/relations", relationsHandler(metadataServiceClient))
+	router.GET("/api/v1/titles/:id/credits", creditsHandler(metadataServiceClient))
+	router.GET("/api/v1/titles/:id/characters", charactersHandler(metadataServiceClient))
+	router.GET("/api/v1/titles/:id/videos", videosHandler(metadataServiceClient))
+	router.GET("/api/v1/titles/:id/recommendations", recommendationsHandler(metadataServiceClient.GetRecommendations, "failed to get recommendations"))
+	router.GET("/api/v1/titles/:id/similar", recommendationsHandler(metadataServiceClient.GetSimilar, "failed to get similar titles"))
+	router.GET("/api/v1/people/:id", personHandler(metadataServiceClient))
+	router.GET("/api/v1/ids/:id", resolveIDsHandler(metadataServiceClient))
+
+	if cfg.Gateway.ImageProxy.Enabled {
+		proxy, err := newImageProxy(cfg.Gateway.ImageProxy)
+		if err != nil {
+			log.Fatalf("failed to start image proxy: %v", err)
+		}
+		router.GET("/img/:size/*path", imageHandler(proxy))
+		router.HEAD("/img/:size/*path", imageHandler(proxy))
+	}
 
-	log.Printf("--- ТЕСТОВАЯ ВЕРСИЯ ЗАПУЩЕНА --- API Gateway слушает порт %s", gatewayPort)
-	err = router.Run(gatewayPort)
+	log.Printf("--- ТЕСТОВАЯ ВЕРСИЯ ЗАПУЩЕНА --- API Gateway слушает порт %s", cfg.Gateway.ListenAddr)
+	err = router.Run(cfg.Gateway.ListenAddr)
 	if err != nil {
 		log.Fatalf("failed to start gateway: %v", err)
 	}
@@ -65,11 +110,11 @@ func getPopularMoviesHandler(metadataServiceClient pb.MetadataServiceClient) fun
 
 		pageInt, err := strconv.Atoi(page)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page parameter"})
+			badRequest(c, "invalid page parameter")
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := requestContext(c, 5*time.Second)
 		defer cancel()
 
 		response, err := metadataServiceClient.GetPopularMovies(ctx, &pb.GetPopularMoviesRequest{
@@ -77,7 +122,7 @@ func getPopularMoviesHandler(metadataServiceClient pb.MetadataServiceClient) fun
 			Language: language,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to fetch popular movies"})
+			grpcError(c, err, "failed to fetch popular movies")
 			return
 		}
 
@@ -98,11 +143,11 @@ func searchMoviesHandler(metadataServiceClient pb.MetadataServiceClient) func(c
 
 		pageInt, err := strconv.Atoi(page)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page parameter"})
+			badRequest(c, "invalid page parameter")
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := requestContext(c, 5*time.Second)
 		defer cancel()
 
 		response, err := metadataServiceClient.SearchMovies(ctx, &pb.SearchRequest{
@@ -111,7 +156,7 @@ func searchMoviesHandler(metadataServiceClient pb.MetadataServiceClient) func(c
 			Language: language,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to search movies"})
+			grpcError(c, err, "failed to search movies")
 			return
 		}
 
@@ -121,25 +166,24 @@ func searchMoviesHandler(metadataServiceClient pb.MetadataServiceClient) func(c
 
 func movieByIDHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		idStr := c.Param("id")
 		language := c.DefaultQuery("language", "ru-RU")
 
-		idInt, err := strconv.ParseInt(idStr, 10, 64)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid movie ID parameter"})
+		idInt, namespacedID, ok := titleID(c.Param("id"))
+		if !ok {
+			badRequest(c, "invalid movie ID parameter")
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := requestContext(c, 5*time.Second)
 		defer cancel()
 
 		response, err := client.GetMovieByID(ctx, &pb.GetMovieByIDRequest{
 			MovieId:  idInt,
 			Language: language,
+			Id:       namespacedID,
 		})
 		if err != nil {
-			log.Printf("ошибка при вызове GetMovieByID: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get movie by ID"})
+			grpcError(c, err, "failed to get movie by ID")
 			return
 		}
 		c.JSON(http.StatusOK, response)
@@ -159,11 +203,11 @@ func searchTVShowsHandler(metadataServiceClient pb.MetadataServiceClient) func(c
 
 		pageInt, err := strconv.Atoi(page)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page parameter"})
+			badRequest(c, "invalid page parameter")
 			return
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		ctx, cancel := requestContext(c, 5*time.Second)
 		defer cancel()
 
 		response, err := metadataServiceClient.SearchTVShows(ctx, &pb.SearchRequest{
@@ -172,7 +216,7 @@ func searchTVShowsHandler(metadataServiceClient pb.MetadataServiceClient) func(c
 			Language: language,
 		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to search TV shows"})
+			grpcError(c, err, "failed to search TV shows")
 			return
 		}
 
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// creditsHandler отдаёт актёров и съёмочную группу тайтла:
+// GET /api/v1/titles/1429/credits?type=tv.
+func creditsHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		id, namespacedID, mediaType, ok := titleParams(c)
+		if !ok {
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetCredits(ctx, &pb.GetCreditsRequest{
+			TitleId:   id,
+			MediaType: mediaType,
+			Language:  language,
+			Id:        namespacedID,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get credits")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// charactersHandler отдаёт персонажей тайтла с актёрами озвучивания:
+// GET /api/v1/titles/anilist:21/characters?type=tv&page=2.
+func charactersHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		id, namespacedID, mediaType, ok := titleParams(c)
+		if !ok {
+			return
+		}
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			badRequest(c, "invalid page parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetCharacters(ctx, &pb.GetCharactersRequest{
+			TitleId:   id,
+			MediaType: mediaType,
+			Language:  language,
+			Id:        namespacedID,
+			Page:      int32(page),
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get characters")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func personHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		personID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			badRequest(c, "invalid person ID parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetPerson(ctx, &pb.GetPersonRequest{
+			PersonId: personID,
+			Language: language,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get person")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// providerMetadataKey совпадает с metadata.ProviderMetadataKey сервиса
+// метаданных: по нему выбирается источник для отдельного запроса.
+const providerMetadataKey = "x-hikari-provider"
+
+// providerHeader — HTTP-заголовок ответа с именем источника, если ответил
+// не выбранный для запроса (например, запасной): ID в таком ответе
+// относятся к нему.
+const providerHeader = "X-Hikari-Provider"
+
+type ginContextKey struct{}
+
+// imageSizesMetadataKey совпадает с metadata.ImageSizesMetadataKey: по
+// нему выбираются размеры изображений в ответе.
+const imageSizesMetadataKey = "x-hikari-image-sizes"
+
+// requestContext возвращает контекст вызова сервиса метаданных с таймаутом
+// timeout. Параметры provider (например, ?provider=anilist) и image_sizes
+// (например, ?image_sizes=w185,original) передаются сервису в
+// gRPC-метаданных.
+func requestContext(c *gin.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx := context.WithValue(context.Background(), ginContextKey{}, c)
+	if name := c.Query("provider"); name != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, providerMetadataKey, name)
+	}
+	if sizes := c.Query("image_sizes"); sizes != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, imageSizesMetadataKey, sizes)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// forwardProvider — клиентский перехватчик, который передаёт в ответ
+// шлюза заголовок providerHeader, если сервис метаданных сообщил, что
+// ответил другой источник.
+func forwardProvider(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	if names := header.Get(providerMetadataKey); len(names) > 0 {
+		if c, ok := ctx.Value(ginContextKey{}).(*gin.Context); ok {
+			c.Header(providerHeader, names[0])
+		}
+	}
+	return err
+}
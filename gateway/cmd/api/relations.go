@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// relationsHandler отдаёт связи тайтла и порядок просмотра франшизы:
+// GET /api/v1/titles/anilist:21/relations?type=tv.
+func relationsHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		id, namespacedID, mediaType, ok := titleParams(c)
+		if !ok {
+			return
+		}
+
+		// Обход франшизы запрашивает связи каждого тайтла, поэтому таймаут
+		// больше, чем у запросов одного тайтла.
+		ctx, cancel := requestContext(c, 15*time.Second)
+		defer cancel()
+
+		response, err := client.GetRelations(ctx, &pb.GetRelationsRequest{
+			TitleId:   id,
+			MediaType: mediaType,
+			Language:  language,
+			Id:        namespacedID,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get relations")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
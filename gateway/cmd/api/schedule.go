@@ -0,0 +1,151 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// icsMaxPages ограничивает число страниц расписания в одном календаре.
+const icsMaxPages = 5
+
+// scheduleHandler отдаёт расписание выхода эпизодов:
+// GET /api/v1/anime/schedule?from=2024-04-01&to=2024-04-07&tz=Europe/Moscow.
+func scheduleHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			badRequest(c, "invalid page parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 10*time.Second)
+		defer cancel()
+
+		response, err := client.GetAiringSchedule(ctx, scheduleRequest(c, int32(page)))
+		if err != nil {
+			grpcError(c, err, "failed to fetch airing schedule")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// scheduleICSHandler отдаёт то же расписание в формате iCalendar, чтобы на
+// него можно было подписаться в календаре. Без from/to — неделя с сегодняшнего дня.
+func scheduleICSHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := requestContext(c, 30*time.Second)
+		defer cancel()
+
+		var episodes []*pb.AiringEpisode
+		for page := int32(1); page <= icsMaxPages; page++ {
+			response, err := client.GetAiringSchedule(ctx, scheduleRequest(c, page))
+			if err != nil {
+				grpcError(c, err, "failed to fetch airing schedule")
+				return
+			}
+			episodes = append(episodes, response.GetResults()...)
+			if page >= response.GetTotalPages() {
+				break
+			}
+		}
+
+		c.Header("Content-Disposition", `inline; filename="hikari-schedule.ics"`)
+		c.Data(http.StatusOK, "text/calendar; charset=utf-8", []byte(icsCalendar(episodes, time.Now())))
+	}
+}
+
+func scheduleRequest(c *gin.Context, page int32) *pb.GetAiringScheduleRequest {
+	return &pb.GetAiringScheduleRequest{
+		From:     c.Query("from"),
+		To:       c.Query("to"),
+		Timezone: c.Query("tz"),
+		Page:     page,
+		Language: c.DefaultQuery("language", "ru-RU"),
+	}
+}
+
+// icsCalendar собирает календарь RFC 5545 из эпизодов. Время событий
+// записывается в UTC: календарь клиента сам переводит его в местное.
+func icsCalendar(episodes []*pb.AiringEpisode, now time.Time) string {
+	var b strings.Builder
+	line := func(s string) {
+		b.WriteString(foldICSLine(s))
+		b.WriteString("\r\n")
+	}
+
+	line("BEGIN:VCALENDAR")
+	line("VERSION:2.0")
+	line("PRODID:-//Hikari//Airing Schedule//EN")
+	line("CALSCALE:GREGORIAN")
+	line("X-WR-CALNAME:Hikari — airing schedule")
+	stamp := now.UTC().Format("20060102T150405Z")
+	for _, e := range episodes {
+		if e.GetAirsAtUnix() == 0 {
+			continue
+		}
+		airsAt := time.Unix(e.GetAirsAtUnix(), 0).UTC()
+
+		line("BEGIN:VEVENT")
+		line(fmt.Sprintf("UID:%d-%d-%d@hikari", e.GetTitle().GetId(), e.GetSeasonNumber(), e.GetEpisodeNumber()))
+		line("DTSTAMP:" + stamp)
+		if e.GetDateOnly() {
+			line("DTSTART;VALUE=DATE:" + airsAt.Format("20060102"))
+		} else {
+			line("DTSTART:" + airsAt.Format("20060102T150405Z"))
+			line(fmt.Sprintf("DURATION:PT%dM", cmp.Or(e.GetRuntime(), 24)))
+		}
+		line("SUMMARY:" + escapeICS(episodeSummary(e)))
+		if overview := e.GetTitle().GetOverview(); overview != "" {
+			line("DESCRIPTION:" + escapeICS(overview))
+		}
+		line("END:VEVENT")
+	}
+	line("END:VCALENDAR")
+	return b.String()
+}
+
+func episodeSummary(e *pb.AiringEpisode) string {
+	summary := fmt.Sprintf("%s — episode %d", e.GetTitle().GetTitle(), e.GetEpisodeNumber())
+	if e.GetSeasonNumber() > 0 {
+		summary = fmt.Sprintf("%s — S%02dE%02d", e.GetTitle().GetTitle(), e.GetSeasonNumber(), e.GetEpisodeNumber())
+	}
+	if e.GetName() != "" {
+		summary += ": " + e.GetName()
+	}
+	return summary
+}
+
+var icsEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeICS(s string) string {
+	return icsEscaper.Replace(s)
+}
+
+// foldICSLine переносит строку длиннее 75 байт, не разрывая символы UTF-8.
+func foldICSLine(s string) string {
+	const limit = 75
+	if len(s) <= limit {
+		return s
+	}
+
+	var b strings.Builder
+	width := 0
+	for _, r := range s {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
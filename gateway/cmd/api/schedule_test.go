@@ -0,0 +1,108 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+func TestEscapeICS(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Frieren", "Frieren"},
+		{"Re:Zero; Season 3, part 2", `Re:Zero\; Season 3\, part 2`},
+		{`C:\anime`, `C:\\anime`},
+		{"first line\nsecond\r\nthird", `first line\nsecond\nthird`},
+		{`\;`, `\\\;`},
+	}
+	for _, tt := range tests {
+		if got := escapeICS(tt.in); got != tt.want {
+			t.Errorf("escapeICS(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestFoldICSLine(t *testing.T) {
+	tests := []struct {
+		name      string
+		in        string
+		wantLines int
+	}{
+		{name: "short line", in: "SUMMARY:Frieren", wantLines: 1},
+		{name: "exactly 75 octets", in: "SUMMARY:" + strings.Repeat("a", 67), wantLines: 1},
+		{name: "76 octets", in: "SUMMARY:" + strings.Repeat("a", 68), wantLines: 2},
+		{name: "long ASCII", in: "DESCRIPTION:" + strings.Repeat("abcdefghij", 20), wantLines: 3},
+		{name: "multibyte runes are not split", in: "SUMMARY:" + strings.Repeat("Атака титанов ", 10), wantLines: 4},
+		{name: "four-byte runes", in: "SUMMARY:" + strings.Repeat("🎬", 40), wantLines: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			folded := foldICSLine(tt.in)
+			lines := strings.Split(folded, "\r\n")
+			if len(lines) != tt.wantLines {
+				t.Errorf("%d lines, want %d: %q", len(lines), tt.wantLines, folded)
+			}
+			for i, line := range lines {
+				if len(line) > 75 {
+					t.Errorf("line %d is %d octets long", i, len(line))
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 sequence: %q", i, line)
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d does not start with a space: %q", i, line)
+				}
+			}
+			// Разворачивание по RFC 5545 возвращает исходную строку.
+			if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != tt.in {
+				t.Errorf("unfolded = %q, want %q", unfolded, tt.in)
+			}
+		})
+	}
+}
+
+func TestICSCalendar(t *testing.T) {
+	now := time.Date(2024, 4, 1, 12, 0, 0, 0, time.UTC)
+	episodes := []*pb.AiringEpisode{
+		{
+			Title:         &pb.Movie{Id: 154587, Title: "Frieren", Overview: "After the party's journey, the elf mage travels on.\nAlone."},
+			EpisodeNumber: 5,
+			AirsAtUnix:    time.Date(2024, 4, 5, 14, 30, 0, 0, time.UTC).Unix(),
+		},
+		{
+			Title:         &pb.Movie{Id: 1429, Title: "Attack on Titan"},
+			SeasonNumber:  4,
+			EpisodeNumber: 29,
+			Name:          "Final, part 3",
+			DateOnly:      true,
+			Runtime:       90,
+			AirsAtUnix:    time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC).Unix(),
+		},
+		{Title: &pb.Movie{Id: 1, Title: "no date"}},
+	}
+
+	ics := icsCalendar(episodes, now)
+	if !strings.HasSuffix(ics, "END:VCALENDAR\r\n") || strings.Contains(strings.ReplaceAll(ics, "\r\n", ""), "\n") {
+		t.Errorf("lines must end with CRLF:\n%s", ics)
+	}
+	for _, want := range []string{
+		"UID:154587-0-5@hikari\r\n",
+		"DTSTAMP:20240401T120000Z\r\n",
+		"DTSTART:20240405T143000Z\r\nDURATION:PT24M\r\n",
+		"SUMMARY:Frieren — episode 5\r\n",
+		`DESCRIPTION:After the party's journey\, the elf mage travels on.\nAlone.`,
+		"UID:1429-4-29@hikari\r\n",
+		"DTSTART;VALUE=DATE:20240406\r\nSUMMARY:Attack on Titan — S04E29: Final\\, part 3\r\n",
+	} {
+		if !strings.Contains(strings.ReplaceAll(ics, "\r\n ", ""), want) {
+			t.Errorf("calendar has no %q:\n%s", want, ics)
+		}
+	}
+	if n := strings.Count(ics, "BEGIN:VEVENT"); n != 2 {
+		t.Errorf("%d events, want 2: episodes without a date are skipped", n)
+	}
+}
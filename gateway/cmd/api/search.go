@@ -0,0 +1,53 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// multiSearchHandler обслуживает /api/v1/search. Параметр type — список
+// типов через запятую (movie, tv, person); по умолчанию ищет везде.
+func multiSearchHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := c.Query("query")
+		language := c.DefaultQuery("language", "ru-RU")
+
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			badRequest(c, "invalid page parameter")
+			return
+		}
+
+		var mediaTypes []pb.MediaType
+		if types := c.Query("type"); types != "" {
+			for _, name := range strings.Split(types, ",") {
+				var mediaType pb.MediaType
+				if err := mediaType.UnmarshalText([]byte(strings.TrimSpace(name))); err != nil || mediaType == pb.MediaType_MEDIA_TYPE_UNSPECIFIED {
+					badRequest(c, "invalid type parameter: expected movie, tv or person")
+					return
+				}
+				mediaTypes = append(mediaTypes, mediaType)
+			}
+		}
+
+		ctx, cancel := requestContext(c, 10*time.Second)
+		defer cancel()
+
+		response, err := client.MultiSearch(ctx, &pb.MultiSearchRequest{
+			Query:      query,
+			Page:       int32(page),
+			Language:   language,
+			MediaTypes: mediaTypes,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to search")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc"
+)
+
+// trendingHandler отдаёт тайтлы, набирающие популярность:
+// GET /api/v1/trending?type=all&window=day.
+func trendingHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		page, mediaType, ok := animeListParams(c)
+		if !ok {
+			return
+		}
+
+		var window pb.TimeWindow
+		if err := window.UnmarshalText([]byte(c.DefaultQuery("window", "week"))); err != nil {
+			badRequest(c, "invalid window parameter: expected day or week")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetTrending(ctx, &pb.GetTrendingRequest{
+			MediaType:  mediaType,
+			TimeWindow: window,
+			Page:       page,
+			Language:   c.DefaultQuery("language", "ru-RU"),
+		})
+		if err != nil {
+			grpcError(c, err, "failed to fetch trending titles")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+// recommendationsHandler отдаёт тайтлы, которые стоит посмотреть после
+// данного: GET /api/v1/titles/1429/recommendations?type=tv. С
+// client.GetSimilar тот же обработчик отдаёт похожие тайтлы.
+func recommendationsHandler(
+	list func(context.Context, *pb.GetRecommendationsRequest, ...grpc.CallOption) (*pb.RecommendationsResponse, error),
+	failure string,
+) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, namespacedID, mediaType, ok := titleParams(c)
+		if !ok {
+			return
+		}
+		page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+		if err != nil || page < 1 {
+			badRequest(c, "invalid page parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := list(ctx, &pb.GetRecommendationsRequest{
+			TitleId:   id,
+			MediaType: mediaType,
+			Language:  c.DefaultQuery("language", "ru-RU"),
+			Id:        namespacedID,
+			Page:      int32(page),
+		})
+		if err != nil {
+			grpcError(c, err, failure)
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
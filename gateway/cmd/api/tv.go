@@ -0,0 +1,105 @@
+package main
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+func tvShowByIDHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		tvID, namespacedID, ok := titleID(c.Param("id"))
+		if !ok {
+			badRequest(c, "invalid TV show ID parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetTVShowByID(ctx, &pb.GetTVShowByIDRequest{
+			TvId:     tvID,
+			Language: language,
+			Id:       namespacedID,
+			Timezone: c.Query("tz"),
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get TV show by ID")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func seasonHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		tvID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			badRequest(c, "invalid TV show ID parameter")
+			return
+		}
+		seasonNumber, err := strconv.ParseInt(c.Param("n"), 10, 32)
+		if err != nil {
+			badRequest(c, "invalid season number parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetSeason(ctx, &pb.GetSeasonRequest{
+			TvId:         tvID,
+			SeasonNumber: int32(seasonNumber),
+			Language:     language,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get season")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
+
+func episodeHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		tvID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			badRequest(c, "invalid TV show ID parameter")
+			return
+		}
+		seasonNumber, err := strconv.ParseInt(c.Param("n"), 10, 32)
+		if err != nil {
+			badRequest(c, "invalid season number parameter")
+			return
+		}
+		episodeNumber, err := strconv.ParseInt(c.Param("e"), 10, 32)
+		if err != nil {
+			badRequest(c, "invalid episode number parameter")
+			return
+		}
+
+		ctx, cancel := requestContext(c, 5*time.Second)
+		defer cancel()
+
+		response, err := client.GetEpisode(ctx, &pb.GetEpisodeRequest{
+			TvId:          tvID,
+			SeasonNumber:  int32(seasonNumber),
+			EpisodeNumber: int32(episodeNumber),
+			Language:      language,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get episode")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// videosHandler отдаёт трейлеры и другие видео тайтла:
+// GET /api/v1/titles/1429/videos?type=tv. Если на языке запроса видео нет,
+// в поле language ответа будет язык, на котором они нашлись.
+func videosHandler(client pb.MetadataServiceClient) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		language := c.DefaultQuery("language", "ru-RU")
+
+		id, namespacedID, mediaType, ok := titleParams(c)
+		if !ok {
+			return
+		}
+
+		// Запасные языки запрашиваются у источника по очереди.
+		ctx, cancel := requestContext(c, 10*time.Second)
+		defer cancel()
+
+		response, err := client.GetVideos(ctx, &pb.GetVideosRequest{
+			TitleId:   id,
+			MediaType: mediaType,
+			Language:  language,
+			Id:        namespacedID,
+		})
+		if err != nil {
+			grpcError(c, err, "failed to get videos")
+			return
+		}
+		c.JSON(http.StatusOK, response)
+	}
+}
@@ -0,0 +1,44 @@
+package metadata
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+func (s *Server) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*pb.StatsResponse, error) {
+	response := &pb.StatsResponse{}
+	if s.cache != nil {
+		stats := s.cache.Stats()
+		response.Cache = &pb.CacheStats{
+			Hits:      stats.Hits,
+			Misses:    stats.Misses,
+			Evictions: stats.Evictions,
+			Expired:   stats.Expired,
+			Entries:   int64(stats.Entries),
+			Bytes:     stats.Bytes,
+		}
+	}
+	if reporter, ok := s.provider.(provider.StatsReporter); ok {
+		stats := reporter.UpstreamStats()
+		response.Upstream = &pb.UpstreamStats{
+			Provider:  s.provider.Name(),
+			Calls:     stats.Calls,
+			Requests:  stats.Requests,
+			Coalesced: stats.Coalesced,
+		}
+	}
+	return response, nil
+}
+
+func (s *Server) PurgeCache(ctx context.Context, req *pb.PurgeCacheRequest) (*pb.PurgeCacheResponse, error) {
+	if s.cache == nil {
+		return &pb.PurgeCacheResponse{}, nil
+	}
+
+	purged := s.cache.Purge(req.GetRpc())
+	log.Printf("Очищен кэш (rpc=%q): удалено %d записей", req.GetRpc(), purged)
+	return &pb.PurgeCacheResponse{Purged: int32(purged)}, nil
+}
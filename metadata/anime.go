@@ -0,0 +1,121 @@
+package metadata
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetPopularAnime(ctx context.Context, req *pb.GetPopularAnimeRequest) (*pb.AnimeListResponse, error) {
+	return s.discoverAnime(ctx, req.GetMediaType(),
+		provider.AnimeQuery(max(req.GetPage(), 1), req.GetLanguage(), pb.SortOrder_SORT_ORDER_POPULARITY_DESC))
+}
+
+func (s *Server) DiscoverAnime(ctx context.Context, req *pb.DiscoverAnimeRequest) (*pb.AnimeListResponse, error) {
+	return s.discoverAnime(ctx, req.GetMediaType(),
+		provider.AnimeQuery(max(req.GetPage(), 1), req.GetLanguage(), req.GetSortBy()))
+}
+
+// minChartYear — год, раньше которого сезонный чарт не строится: первые
+// японские анимационные фильмы вышли в 1917 году.
+const minChartYear = 1917
+
+func (s *Server) GetSeasonalChart(ctx context.Context, req *pb.GetSeasonalChartRequest) (*pb.SeasonalChartResponse, error) {
+	if req.GetYear() < minChartYear || req.GetYear() > int32(time.Now().Year())+2 {
+		return nil, status.Errorf(codes.InvalidArgument, "некорректный год (year): %d", req.GetYear())
+	}
+	if req.GetSeason() == pb.AnimeSeason_ANIME_SEASON_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "сезон (season) не задан")
+	}
+	for _, f := range req.GetFormats() {
+		if f == pb.TitleFormat_TITLE_FORMAT_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "некорректный формат в formats")
+		}
+	}
+
+	page, err := s.providerFor(ctx).SeasonalChart(ctx, provider.SeasonQuery{
+		Year:     req.GetYear(),
+		Season:   req.GetSeason(),
+		Page:     max(req.GetPage(), 1),
+		Language: req.GetLanguage(),
+		SortBy:   req.GetSortBy(),
+		Formats:  req.GetFormats(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SeasonalChartResponse{
+		Year:         req.GetYear(),
+		Season:       req.GetSeason(),
+		Results:      page.Results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}, nil
+}
+
+// discoverAnime подбирает аниме нужного типа. Для MEDIA_TYPE_UNSPECIFIED
+// одна и та же страница запрашивается среди фильмов и сериалов, и
+// результаты сливаются в порядке query.SortBy.
+func (s *Server) discoverAnime(ctx context.Context, mediaType pb.MediaType, query provider.DiscoverQuery) (*pb.AnimeListResponse, error) {
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE, pb.MediaType_MEDIA_TYPE_TV:
+		page, err := s.providerFor(ctx).Discover(ctx, mediaType, query)
+		if err != nil {
+			return nil, err
+		}
+		return animeList(page), nil
+	case pb.MediaType_MEDIA_TYPE_UNSPECIFIED:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "media_type должен быть фильмом, сериалом или не задан")
+	}
+
+	var (
+		wg              sync.WaitGroup
+		movies, tv      *provider.MoviePage
+		movieErr, tvErr error
+	)
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		movies, movieErr = s.providerFor(ctx).Discover(ctx, pb.MediaType_MEDIA_TYPE_MOVIE, query)
+	}()
+	go func() {
+		defer wg.Done()
+		tv, tvErr = s.providerFor(ctx).Discover(ctx, pb.MediaType_MEDIA_TYPE_TV, query)
+	}()
+	wg.Wait()
+
+	if movieErr != nil {
+		return nil, movieErr
+	}
+	if tvErr != nil {
+		return nil, tvErr
+	}
+
+	results := append(slices.Clone(movies.Results), tv.Results...)
+	slices.SortStableFunc(results, provider.CompareBy(query.SortBy))
+
+	return &pb.AnimeListResponse{
+		Results:      results,
+		Page:         query.Page,
+		TotalPages:   max(movies.TotalPages, tv.TotalPages),
+		TotalResults: movies.TotalResults + tv.TotalResults,
+	}, nil
+}
+
+func animeList(page *provider.MoviePage) *pb.AnimeListResponse {
+	return &pb.AnimeListResponse{
+		Results:      page.Results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}
+}
@@ -0,0 +1,85 @@
+package metadata
+
+import (
+	"context"
+	"log"
+	"path"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// CacheInterceptor возвращает серверный перехватчик, который отдаёт ответы
+// MetadataService из кэша и сохраняет туда успешные ответы. Методы с
+// нулевым TTL, административные методы, ответы запасного источника и
+// неполные ответы не кэшируются.
+func (s *Server) CacheInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if s.cache == nil || info.Server != s {
+			return handler(ctx, req)
+		}
+
+		rpc := path.Base(info.FullMethod)
+		if adminRPCs[rpc] || localRPCs[rpc] || s.cache.TTL(rpc) <= 0 {
+			return handler(ctx, req)
+		}
+
+		key, ok := s.cacheKey(ctx, rpc, req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if cached, ok := s.cache.Get(key); ok {
+			return cached, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if msg, ok := resp.(proto.Message); ok && storable(ctx) {
+			s.cache.Set(key, msg)
+		}
+		return resp, nil
+	}
+}
+
+// adminRPCs — методы, ответы которых нельзя кэшировать.
+var adminRPCs = map[string]bool{
+	"GetStats":   true,
+	"PurgeCache": true,
+}
+
+// localRPCs — методы, которые отвечают из памяти сервиса без обращения к
+// источнику: кэшировать их незачем, а устаревший ответ скрыл бы новые данные.
+var localRPCs = map[string]bool{
+	"ResolveIDs": true,
+}
+
+// cacheKey строит ключ кэша и каталога из имени метода и запроса. Ответы
+// источников, выбранных через ProviderMetadataKey, хранятся отдельно от
+// ответов основного источника. Расписание хранится под датами, на
+// которые оно получено (см. resolvedSchedule).
+func (s *Server) cacheKey(ctx context.Context, rpc string, req any) (cache.Key, bool) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return cache.Key{}, false
+	}
+	if r, ok := msg.(*pb.GetAiringScheduleRequest); ok {
+		msg = resolvedSchedule(r, time.Now())
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		log.Printf("не удалось построить ключ кэша для %s: %v", rpc, err)
+		return cache.Key{}, false
+	}
+	request := string(data)
+	if !s.usesDefaultProvider(ctx) {
+		request = s.providerFor(ctx).Name() + "\x00" + request
+	}
+	return cache.Key{RPC: rpc, Request: request}, true
+}
@@ -0,0 +1,175 @@
+// Package cache реализует ограниченный по размеру кэш ответов в памяти
+// с вытеснением по LRU и временем жизни записей, заданным для каждого RPC.
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Key идентифицирует закэшированный ответ.
+type Key struct {
+	// RPC — короткое имя метода, например "SearchMovies".
+	RPC string
+	// Request — детерминированная сериализация запроса: query, page,
+	// language, id и остальные поля сообщения.
+	Request string
+}
+
+type Config struct {
+	// MaxEntries ограничивает количество записей; 0 — без ограничения.
+	MaxEntries int
+	// MaxBytes ограничивает суммарный размер ответов; 0 — без ограничения.
+	MaxBytes int64
+	// DefaultTTL применяется к RPC, для которых нет значения в TTL.
+	DefaultTTL time.Duration
+	// TTL задаёт время жизни по имени RPC. Нулевое значение отключает
+	// кэширование метода.
+	TTL map[string]time.Duration
+}
+
+// Stats — счётчики кэша на момент вызова Stats.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	Expired   uint64
+	Entries   int
+	Bytes     int64
+}
+
+type entry struct {
+	key       Key
+	value     proto.Message
+	size      int64
+	expiresAt time.Time
+}
+
+type Cache struct {
+	cfg Config
+
+	mu    sync.Mutex
+	ll    *list.List
+	items map[Key]*list.Element
+	bytes int64
+	stats Stats
+}
+
+func New(cfg Config) *Cache {
+	return &Cache{
+		cfg:   cfg,
+		ll:    list.New(),
+		items: make(map[Key]*list.Element),
+	}
+}
+
+// TTL возвращает время жизни записей для rpc.
+func (c *Cache) TTL(rpc string) time.Duration {
+	if ttl, ok := c.cfg.TTL[rpc]; ok {
+		return ttl
+	}
+	return c.cfg.DefaultTTL
+}
+
+// Get возвращает копию закэшированного ответа. Истёкшие записи удаляются.
+func (c *Cache) Get(key Key) (proto.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if !time.Now().Before(e.expiresAt) {
+		c.removeElement(el)
+		c.stats.Expired++
+		c.stats.Misses++
+		return nil, false
+	}
+
+	c.ll.MoveToFront(el)
+	c.stats.Hits++
+	return proto.Clone(e.value), true
+}
+
+// Set сохраняет копию value на время TTL(key.RPC) и вытесняет самые
+// давно использованные записи, если превышены лимиты.
+func (c *Cache) Set(key Key, value proto.Message) {
+	ttl := c.TTL(key.RPC)
+	if ttl <= 0 {
+		return
+	}
+
+	size := int64(proto.Size(value) + len(key.RPC) + len(key.Request))
+	if c.cfg.MaxBytes > 0 && size > c.cfg.MaxBytes {
+		return
+	}
+
+	e := &entry{
+		key:       key,
+		value:     proto.Clone(value),
+		size:      size,
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+	c.items[key] = c.ll.PushFront(e)
+	c.bytes += size
+
+	for c.overLimit() {
+		c.removeElement(c.ll.Back())
+		c.stats.Evictions++
+	}
+}
+
+// Purge удаляет записи метода rpc, а при пустом rpc — все записи.
+// Возвращает количество удалённых записей.
+func (c *Cache) Purge(rpc string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	purged := 0
+	for key, el := range c.items {
+		if rpc == "" || key.RPC == rpc {
+			c.removeElement(el)
+			purged++
+		}
+	}
+	return purged
+}
+
+func (c *Cache) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := c.stats
+	stats.Entries = c.ll.Len()
+	stats.Bytes = c.bytes
+	return stats
+}
+
+func (c *Cache) overLimit() bool {
+	if c.ll.Len() == 0 {
+		return false
+	}
+	return (c.cfg.MaxEntries > 0 && c.ll.Len() > c.cfg.MaxEntries) ||
+		(c.cfg.MaxBytes > 0 && c.bytes > c.cfg.MaxBytes)
+}
+
+func (c *Cache) removeElement(el *list.Element) {
+	e := el.Value.(*entry)
+	c.ll.Remove(el)
+	delete(c.items, e.key)
+	c.bytes -= e.size
+}
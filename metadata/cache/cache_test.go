@@ -0,0 +1,143 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCacheEviction(t *testing.T) {
+	key := func(request string) Key {
+		return Key{RPC: "SearchMovies", Request: request}
+	}
+	value := &pb.Movie{Title: "Shingeki no Kyojin"}
+	entrySize := int64(proto.Size(value) + len("SearchMovies") + len("a"))
+
+	tests := []struct {
+		name string
+		cfg  Config
+		// ops — последовательность операций: "+a" сохраняет запись a,
+		// "?a" читает её.
+		ops       []string
+		want      []string
+		evicted   []string
+		evictions uint64
+	}{
+		{
+			name:      "вытесняется самая старая запись",
+			cfg:       Config{MaxEntries: 2},
+			ops:       []string{"+a", "+b", "+c"},
+			want:      []string{"b", "c"},
+			evicted:   []string{"a"},
+			evictions: 1,
+		},
+		{
+			name:      "чтение продлевает жизнь записи",
+			cfg:       Config{MaxEntries: 2},
+			ops:       []string{"+a", "+b", "?a", "+c"},
+			want:      []string{"a", "c"},
+			evicted:   []string{"b"},
+			evictions: 1,
+		},
+		{
+			name:      "повторное сохранение не считается новой записью",
+			cfg:       Config{MaxEntries: 2},
+			ops:       []string{"+a", "+b", "+a", "+a"},
+			want:      []string{"a", "b"},
+			evictions: 0,
+		},
+		{
+			name:      "лимит по байтам",
+			cfg:       Config{MaxBytes: 2 * entrySize},
+			ops:       []string{"+a", "+b", "+c"},
+			want:      []string{"b", "c"},
+			evicted:   []string{"a"},
+			evictions: 1,
+		},
+		{
+			name:    "ответ больше лимита не сохраняется",
+			cfg:     Config{MaxBytes: entrySize - 1},
+			ops:     []string{"+a"},
+			evicted: []string{"a"},
+		},
+		{
+			name:    "нулевой TTL отключает кэширование",
+			cfg:     Config{TTL: map[string]time.Duration{"SearchMovies": 0}},
+			ops:     []string{"+a"},
+			evicted: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.cfg.DefaultTTL == 0 {
+				tt.cfg.DefaultTTL = time.Hour
+			}
+			c := New(tt.cfg)
+			for _, op := range tt.ops {
+				switch op[0] {
+				case '+':
+					c.Set(key(op[1:]), value)
+				case '?':
+					c.Get(key(op[1:]))
+				}
+			}
+
+			if got := c.Stats().Evictions; got != tt.evictions {
+				t.Errorf("Evictions = %d, want %d", got, tt.evictions)
+			}
+			for _, r := range tt.want {
+				if _, ok := c.Get(key(r)); !ok {
+					t.Errorf("запись %q вытеснена", r)
+				}
+			}
+			for _, r := range tt.evicted {
+				if _, ok := c.Get(key(r)); ok {
+					t.Errorf("запись %q не вытеснена", r)
+				}
+			}
+		})
+	}
+}
+
+func TestCacheTTL(t *testing.T) {
+	c := New(Config{
+		DefaultTTL: time.Hour,
+		TTL:        map[string]time.Duration{"GetTrending": 20 * time.Millisecond},
+	})
+	short := Key{RPC: "GetTrending", Request: "a"}
+	long := Key{RPC: "GetPopularMovies", Request: "a"}
+	c.Set(short, &pb.Movie{Id: 1})
+	c.Set(long, &pb.Movie{Id: 2})
+
+	if _, ok := c.Get(short); !ok {
+		t.Fatal("запись пропала до истечения TTL")
+	}
+	time.Sleep(30 * time.Millisecond)
+
+	if _, ok := c.Get(short); ok {
+		t.Error("запись с истёкшим TTL отдана из кэша")
+	}
+	if _, ok := c.Get(long); !ok {
+		t.Error("запись метода с длинным TTL пропала")
+	}
+	stats := c.Stats()
+	if stats.Expired != 1 || stats.Entries != 1 {
+		t.Errorf("Expired = %d, Entries = %d, want 1, 1", stats.Expired, stats.Entries)
+	}
+}
+
+func TestCacheGetReturnsCopy(t *testing.T) {
+	c := New(Config{DefaultTTL: time.Hour})
+	key := Key{RPC: "GetMovieByID", Request: "1"}
+	c.Set(key, &pb.Movie{Title: "Shingeki no Kyojin"})
+
+	got, _ := c.Get(key)
+	got.(*pb.Movie).Title = "изменено"
+
+	again, _ := c.Get(key)
+	if title := again.(*pb.Movie).GetTitle(); title != "Shingeki no Kyojin" {
+		t.Errorf("Title = %q: изменение копии попало в кэш", title)
+	}
+}
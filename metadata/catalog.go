@@ -0,0 +1,153 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	"github.com/waste3d/Hikari-Anime/metadata/catalog"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// movieListRPCs — методы, фильмы из ответов которых сохраняются в каталог
+// как отдельные карточки. Результаты поиска сериалов сюда не входят: ID
+// сериалов в TMDb пересекаются с ID фильмов.
+var movieListRPCs = map[string]bool{
+	"GetPopularMovies": true,
+	"SearchMovies":     true,
+}
+
+// CatalogInterceptor возвращает серверный перехватчик, который сохраняет
+// успешные ответы в постоянный каталог, отдаёт из него ответы моложе
+// freshFor и подставляет сохранённые данные, если источник вернул ошибку.
+// Ответы запасного источника и неполные ответы не сохраняются.
+func (s *Server) CatalogInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if s.catalog == nil || info.Server != s {
+			return handler(ctx, req)
+		}
+
+		rpc := path.Base(info.FullMethod)
+		if adminRPCs[rpc] || localRPCs[rpc] {
+			return handler(ctx, req)
+		}
+
+		key, ok := s.cacheKey(ctx, rpc, req)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		stored, storedAt, lookupErr := s.catalog.Response(key)
+		if lookupErr != nil && !errors.Is(lookupErr, catalog.ErrNotFound) {
+			log.Printf("ошибка чтения каталога для %s: %v", rpc, lookupErr)
+		}
+		if lookupErr == nil && time.Since(storedAt) < s.catalogFreshFor {
+			return stored, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err == nil {
+			if msg, ok := resp.(proto.Message); ok && storable(ctx) {
+				s.storeInCatalog(ctx, rpc, key, req, msg)
+			}
+			return resp, nil
+		}
+
+		if !canServeStale(err) {
+			return nil, err
+		}
+		if lookupErr == nil {
+			log.Printf("Источник недоступен (%v), отдаю %s из каталога от %s", err, rpc, storedAt.Format(time.RFC3339))
+			return stored, nil
+		}
+		if movieReq, ok := req.(*pb.GetMovieByIDRequest); ok && s.usesDefaultProvider(ctx) {
+			if movie, storedAt, lookupErr := s.catalog.Movie(movieReq.GetMovieId()); lookupErr == nil {
+				log.Printf("Источник недоступен (%v), отдаю фильм %d из каталога от %s", err, movie.GetId(), storedAt.Format(time.RFC3339))
+				return detailsFromSummary(movie), nil
+			}
+		}
+		return nil, err
+	}
+}
+
+func (s *Server) storeInCatalog(ctx context.Context, rpc string, key cache.Key, req any, msg proto.Message) {
+	if err := s.catalog.PutResponse(key, msg); err != nil {
+		log.Printf("не удалось сохранить ответ %s в каталог: %v", rpc, err)
+	}
+
+	// Карточки фильмов хранятся по ID основного источника.
+	if !s.usesDefaultProvider(ctx) {
+		return
+	}
+
+	var movies []*pb.Movie
+	switch resp := msg.(type) {
+	case *pb.TitleDetails:
+		// По ID с пространством имён фильм мог прийти из другого источника.
+		if movieReq, ok := req.(*pb.GetMovieByIDRequest); ok && movieReq.GetId() == "" {
+			movies = []*pb.Movie{summaryFromDetails(resp)}
+		}
+	case interface{ GetResults() []*pb.Movie }:
+		if movieListRPCs[rpc] {
+			movies = resp.GetResults()
+		}
+	}
+	if len(movies) == 0 {
+		return
+	}
+
+	if err := s.catalog.PutMovies(movies); err != nil {
+		log.Printf("не удалось сохранить фильмы из %s в каталог: %v", rpc, err)
+	}
+}
+
+// canServeStale сообщает, можно ли заменить ошибку сохранённым ответом:
+// ошибки в самом запросе клиента так не маскируются.
+func canServeStale(err error) bool {
+	switch statusCode(err) {
+	case codes.InvalidArgument, codes.NotFound, codes.Canceled:
+		return false
+	}
+	return true
+}
+
+func summaryFromDetails(d *pb.TitleDetails) *pb.Movie {
+	return &pb.Movie{
+		Id:                d.GetId(),
+		Title:             d.GetTitle(),
+		OriginalTitle:     d.GetOriginalTitle(),
+		Overview:          d.GetOverview(),
+		PosterPath:        d.GetPosterPath(),
+		Poster:            d.GetPoster(),
+		Backdrop:          d.GetBackdrop(),
+		ReleaseDate:       d.GetReleaseDate(),
+		VoteAverage:       d.GetVoteAverage(),
+		MediaType:         d.GetMediaType(),
+		Popularity:        d.GetPopularity(),
+		AlternativeTitles: d.GetAlternativeTitles(),
+	}
+}
+
+// detailsFromSummary восстанавливает неполную подробную карточку из
+// краткой: лучше, чем ошибка, когда источник недоступен.
+func detailsFromSummary(m *pb.Movie) *pb.TitleDetails {
+	return &pb.TitleDetails{
+		Id:                m.GetId(),
+		MediaType:         m.GetMediaType(),
+		Title:             m.GetTitle(),
+		OriginalTitle:     m.GetOriginalTitle(),
+		Overview:          m.GetOverview(),
+		PosterPath:        m.GetPosterPath(),
+		Poster:            m.GetPoster(),
+		Backdrop:          m.GetBackdrop(),
+		ReleaseDate:       m.GetReleaseDate(),
+		VoteAverage:       m.GetVoteAverage(),
+		AlternativeTitles: m.GetAlternativeTitles(),
+	}
+}
@@ -0,0 +1,339 @@
+// Package catalog хранит полученные от источников ответы и карточки
+// фильмов во встроенной базе bbolt, чтобы сервис метаданных после
+// перезапуска стартовал «тёплым» и мог отвечать, когда TMDb недоступен.
+package catalog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// SchemaVersion — текущая версия формата данных. При её увеличении
+// Open вызывает миграцию из migrations.
+const SchemaVersion = 2
+
+var (
+	metaBucket      = []byte("meta")
+	responsesBucket = []byte("responses")
+	moviesBucket    = []byte("movies")
+
+	schemaVersionKey = []byte("schema_version")
+)
+
+// migrations[v] переводит базу из версии v в v+1.
+var migrations = map[int]func(tx *bolt.Tx) error{
+	// В v2 GetMovieByID стал возвращать TitleDetails вместо Movie:
+	// сохранённые ответы старого формата отбрасываются.
+	1: func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket(responsesBucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return err
+		}
+		return nil
+	},
+}
+
+// ErrNotFound возвращается, если записи нет в каталоге.
+var ErrNotFound = errors.New("запись не найдена в каталоге")
+
+// Store — каталог поверх файла bbolt. Файл блокируется на время работы,
+// поэтому одновременно его может открыть только один процесс.
+type Store struct {
+	db *bolt.DB
+}
+
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("не удалось открыть каталог %s: %w", path, err)
+	}
+
+	if err := db.Update(migrate); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+func migrate(tx *bolt.Tx) error {
+	meta, err := tx.CreateBucketIfNotExists(metaBucket)
+	if err != nil {
+		return err
+	}
+
+	version := 0
+	if raw := meta.Get(schemaVersionKey); raw != nil {
+		version = int(binary.BigEndian.Uint32(raw))
+	} else if tx.Bucket(responsesBucket) == nil {
+		// Новая база: сразу создаём актуальную схему.
+		version = SchemaVersion
+	}
+
+	if version > SchemaVersion {
+		return fmt.Errorf("каталог имеет версию схемы %d, а сервер поддерживает только %d", version, SchemaVersion)
+	}
+	for ; version < SchemaVersion; version++ {
+		step, ok := migrations[version]
+		if !ok {
+			return fmt.Errorf("нет миграции каталога с версии %d", version)
+		}
+		if err := step(tx); err != nil {
+			return fmt.Errorf("ошибка миграции каталога с версии %d: %w", version, err)
+		}
+	}
+
+	for _, name := range [][]byte{responsesBucket, moviesBucket} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+
+	return meta.Put(schemaVersionKey, binary.BigEndian.AppendUint32(nil, SchemaVersion))
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Response возвращает сохранённый ответ на запрос key и время его записи.
+func (s *Store) Response(key cache.Key) (proto.Message, time.Time, error) {
+	return s.get(responsesBucket, responseKey(key))
+}
+
+// PutResponse сохраняет ответ на запрос key.
+func (s *Store) PutResponse(key cache.Key, msg proto.Message) error {
+	return s.put(responsesBucket, responseKey(key), msg)
+}
+
+// Movie возвращает карточку фильма, сохранённую из любого ответа.
+func (s *Store) Movie(id int64) (*pb.Movie, time.Time, error) {
+	msg, storedAt, err := s.get(moviesBucket, movieKey(id))
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	movie, ok := msg.(*pb.Movie)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("в каталоге под ID %d лежит %T вместо фильма", id, msg)
+	}
+	return movie, storedAt, nil
+}
+
+// PutMovies сохраняет карточки фильмов одной транзакцией.
+func (s *Store) PutMovies(movies []*pb.Movie) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(moviesBucket)
+		for _, movie := range movies {
+			value, err := encodeRecord(movie, time.Now())
+			if err != nil {
+				return err
+			}
+			if err := bucket.Put(movieKey(movie.GetId()), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// EvictOlderThan удаляет записи, сохранённые раньше чем age назад.
+// Возвращает количество удалённых записей.
+func (s *Store) EvictOlderThan(age time.Duration) (int, error) {
+	cutoff := time.Now().Add(-age)
+	evicted := 0
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{responsesBucket, moviesBucket} {
+			c := tx.Bucket(name).Cursor()
+			for k, v := c.First(); k != nil; {
+				storedAt, err := recordTime(v)
+				if err != nil || storedAt.Before(cutoff) {
+					k = bytes.Clone(k)
+					if err := c.Delete(); err != nil {
+						return err
+					}
+					evicted++
+					// После Delete курсор уже указывает на следующий элемент.
+					k, v = c.Seek(k)
+					continue
+				}
+				k, v = c.Next()
+			}
+		}
+		return nil
+	})
+	return evicted, err
+}
+
+// EachResponse вызывает fn для каждого сохранённого ответа. Повреждённые
+// записи пропускаются.
+func (s *Store) EachResponse(fn func(key cache.Key, msg proto.Message)) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(responsesBucket).ForEach(func(k, v []byte) error {
+			rpc, request, ok := bytes.Cut(k, []byte{0})
+			if !ok {
+				return nil
+			}
+			msg, _, err := decodeRecord(v)
+			if err != nil {
+				return nil
+			}
+			fn(cache.Key{RPC: string(rpc), Request: string(request)}, msg)
+			return nil
+		})
+	})
+}
+
+// Stats описывает содержимое каталога.
+type Stats struct {
+	SchemaVersion int
+	Responses     int
+	Movies        int
+	FileSize      int64
+}
+
+func (s *Store) Stats() (Stats, error) {
+	stats := Stats{SchemaVersion: SchemaVersion}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		stats.Responses = tx.Bucket(responsesBucket).Stats().KeyN
+		stats.Movies = tx.Bucket(moviesBucket).Stats().KeyN
+		stats.FileSize = tx.Size()
+		return nil
+	})
+	return stats, err
+}
+
+// Compact переписывает файл каталога path без свободных страниц,
+// оставшихся после удаления записей. Каталог не должен быть открыт
+// другим процессом.
+func Compact(path string) (before, after int64, err error) {
+	src, err := Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	tmpPath := path + ".compact"
+	if err := compactInto(src.db, tmpPath); err != nil {
+		src.Close()
+		os.Remove(tmpPath)
+		return 0, 0, fmt.Errorf("ошибка сжатия каталога: %w", err)
+	}
+	if err := src.Close(); err != nil {
+		os.Remove(tmpPath)
+		return 0, 0, err
+	}
+
+	srcInfo, err := os.Stat(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	dstInfo, err := os.Stat(tmpPath)
+	if err != nil {
+		return 0, 0, err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return 0, 0, err
+	}
+	return srcInfo.Size(), dstInfo.Size(), nil
+}
+
+func compactInto(src *bolt.DB, dstPath string) error {
+	dst, err := bolt.Open(dstPath, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return err
+	}
+	if err := bolt.Compact(dst, src, 64<<20); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+func (s *Store) get(bucket, key []byte) (proto.Message, time.Time, error) {
+	var raw []byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if v := tx.Bucket(bucket).Get(key); v != nil {
+			raw = bytes.Clone(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if raw == nil {
+		return nil, time.Time{}, ErrNotFound
+	}
+
+	return decodeRecord(raw)
+}
+
+func (s *Store) put(bucket, key []byte, msg proto.Message) error {
+	value, err := encodeRecord(msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(bucket).Put(key, value)
+	})
+}
+
+// Запись хранится как 8 байт времени сохранения (UnixNano, big-endian)
+// и следом сообщение, упакованное в google.protobuf.Any.
+func encodeRecord(msg proto.Message, storedAt time.Time) ([]byte, error) {
+	packed, err := anypb.New(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := proto.Marshal(packed)
+	if err != nil {
+		return nil, err
+	}
+
+	record := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(data)), uint64(storedAt.UnixNano()))
+	return append(record, data...), nil
+}
+
+func decodeRecord(record []byte) (proto.Message, time.Time, error) {
+	storedAt, err := recordTime(record)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var packed anypb.Any
+	if err := proto.Unmarshal(record[8:], &packed); err != nil {
+		return nil, time.Time{}, fmt.Errorf("повреждённая запись каталога: %w", err)
+	}
+
+	msg, err := packed.UnmarshalNew()
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("повреждённая запись каталога: %w", err)
+	}
+	return msg, storedAt, nil
+}
+
+func recordTime(record []byte) (time.Time, error) {
+	if len(record) < 8 {
+		return time.Time{}, errors.New("повреждённая запись каталога: слишком короткая")
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(record[:8]))), nil
+}
+
+func responseKey(key cache.Key) []byte {
+	return []byte(key.RPC + "\x00" + key.Request)
+}
+
+func movieKey(id int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(id))
+}
@@ -0,0 +1,251 @@
+package catalog
+
+import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// createDB создаёт файл bbolt и заполняет его через fill.
+func createDB(t *testing.T, fill func(tx *bolt.Tx) error) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "catalog.db")
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fill != nil {
+		if err := db.Update(fill); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// withVersion записывает в базу версию схемы version и по записи в
+// каждый бакет.
+func withVersion(version uint32) func(tx *bolt.Tx) error {
+	return func(tx *bolt.Tx) error {
+		meta, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+		if err := meta.Put(schemaVersionKey, binary.BigEndian.AppendUint32(nil, version)); err != nil {
+			return err
+		}
+		return putRecords(tx, time.Now())
+	}
+}
+
+func putRecords(tx *bolt.Tx, storedAt time.Time) error {
+	for _, name := range [][]byte{responsesBucket, moviesBucket} {
+		bucket, err := tx.CreateBucketIfNotExists(name)
+		if err != nil {
+			return err
+		}
+		record, err := encodeRecord(&pb.Movie{Id: 1}, storedAt)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put(movieKey(1), record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestOpenMigrates(t *testing.T) {
+	tests := []struct {
+		name          string
+		fill          func(tx *bolt.Tx) error
+		wantErr       bool
+		wantResponses int
+		wantMovies    int
+	}{
+		{
+			name: "новая база",
+		},
+		{
+			name:          "актуальная версия не меняется",
+			fill:          withVersion(SchemaVersion),
+			wantResponses: 1,
+			wantMovies:    1,
+		},
+		{
+			name:       "v1: ответы старого формата отбрасываются, фильмы остаются",
+			fill:       withVersion(1),
+			wantMovies: 1,
+		},
+		{
+			name: "база без версии с данными",
+			fill: func(tx *bolt.Tx) error {
+				return putRecords(tx, time.Now())
+			},
+			wantErr: true,
+		},
+		{
+			name:    "версия новее сервера",
+			fill:    withVersion(SchemaVersion + 1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Open(createDB(t, tt.fill))
+			if tt.wantErr {
+				if err == nil {
+					s.Close()
+					t.Fatal("Open без ошибки")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			stats, err := s.Stats()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stats.Responses != tt.wantResponses || stats.Movies != tt.wantMovies {
+				t.Errorf("Responses = %d, Movies = %d, want %d, %d", stats.Responses, stats.Movies, tt.wantResponses, tt.wantMovies)
+			}
+
+			var version uint32
+			err = s.db.View(func(tx *bolt.Tx) error {
+				version = binary.BigEndian.Uint32(tx.Bucket(metaBucket).Get(schemaVersionKey))
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if version != SchemaVersion {
+				t.Errorf("версия схемы = %d, want %d", version, SchemaVersion)
+			}
+		})
+	}
+}
+
+func TestEvictOlderThan(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		// ages — возраст записей по порядку ключей; отрицательный —
+		// повреждённая запись.
+		ages        []time.Duration
+		wantEvicted int
+		wantKept    []int64
+	}{
+		{
+			name:     "все свежие",
+			ages:     []time.Duration{time.Minute, time.Minute},
+			wantKept: []int64{1, 2},
+		},
+		{
+			name:        "старые подряд",
+			ages:        []time.Duration{2 * time.Hour, 3 * time.Hour, time.Minute},
+			wantEvicted: 2,
+			wantKept:    []int64{3},
+		},
+		{
+			name:        "старые вперемешку со свежими",
+			ages:        []time.Duration{time.Minute, 2 * time.Hour, time.Minute, 2 * time.Hour},
+			wantEvicted: 2,
+			wantKept:    []int64{1, 3},
+		},
+		{
+			name:        "повреждённые записи удаляются",
+			ages:        []time.Duration{-1, time.Minute},
+			wantEvicted: 1,
+			wantKept:    []int64{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := Open(filepath.Join(t.TempDir(), "catalog.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer s.Close()
+
+			err = s.db.Update(func(tx *bolt.Tx) error {
+				for i, age := range tt.ages {
+					id := int64(i + 1)
+					record := []byte{1}
+					if age >= 0 {
+						if record, err = encodeRecord(&pb.Movie{Id: id}, now.Add(-age)); err != nil {
+							return err
+						}
+					}
+					if err := tx.Bucket(moviesBucket).Put(movieKey(id), record); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			evicted, err := s.EvictOlderThan(time.Hour)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if evicted != tt.wantEvicted {
+				t.Errorf("evicted = %d, want %d", evicted, tt.wantEvicted)
+			}
+			for i := range tt.ages {
+				id := int64(i + 1)
+				_, _, err := s.Movie(id)
+				kept := err == nil
+				if want := slices.Contains(tt.wantKept, id); kept != want {
+					t.Errorf("фильм %d: сохранён = %v, want %v (%v)", id, kept, want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestResponseRoundTrip(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "catalog.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	key := cache.Key{RPC: "SearchMovies", Request: "shingeki"}
+	if _, _, err := s.Response(key); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Response до сохранения: %v, want ErrNotFound", err)
+	}
+	if err := s.PutResponse(key, &pb.SearchResponse{Results: []*pb.Movie{{Id: 1429}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	msg, _, err := s.Response(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := msg.(*pb.SearchResponse).GetResults()[0].GetId(); got != 1429 {
+		t.Errorf("ID = %d, want 1429", got)
+	}
+
+	var keys []cache.Key
+	if err := s.EachResponse(func(k cache.Key, _ proto.Message) { keys = append(keys, k) }); err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || keys[0] != key {
+		t.Errorf("EachResponse: %v, want [%v]", keys, key)
+	}
+}
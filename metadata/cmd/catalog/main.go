@@ -0,0 +1,83 @@
+// file: metadata/cmd/catalog/main.go
+//
+// Обслуживание постоянного каталога сервиса метаданных:
+//
+//	catalog [-config путь] stats
+//	catalog [-config путь] evict [-older-than 720h]
+//	catalog [-config путь] compact
+//
+// evict и compact требуют, чтобы сервер метаданных был остановлен: файл
+// каталога блокируется открывшим его процессом.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/config"
+	"github.com/waste3d/Hikari-Anime/metadata/catalog"
+)
+
+func main() {
+	configPath := flag.String("config", os.Getenv(config.EnvConfigPath), "путь к JSON-файлу конфигурации")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "использование: %s [-config путь] stats|evict|compact\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("не удалось загрузить конфигурацию: %v", err)
+	}
+	path := cfg.Metadata.Catalog.Path
+
+	switch flag.Arg(0) {
+	case "stats":
+		store := openStore(path)
+		defer store.Close()
+
+		stats, err := store.Stats()
+		if err != nil {
+			log.Fatalf("не удалось получить статистику: %v", err)
+		}
+		fmt.Printf("схема: v%d\nответов: %d\nфильмов: %d\nразмер: %d байт\n",
+			stats.SchemaVersion, stats.Responses, stats.Movies, stats.FileSize)
+
+	case "evict":
+		fs := flag.NewFlagSet("evict", flag.ExitOnError)
+		olderThan := fs.Duration("older-than", time.Duration(cfg.Metadata.Catalog.Retention), "удалить записи старше указанного возраста")
+		fs.Parse(flag.Args()[1:])
+
+		store := openStore(path)
+		defer store.Close()
+
+		evicted, err := store.EvictOlderThan(*olderThan)
+		if err != nil {
+			log.Fatalf("ошибка очистки каталога: %v", err)
+		}
+		fmt.Printf("удалено записей: %d\n", evicted)
+
+	case "compact":
+		before, after, err := catalog.Compact(path)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		fmt.Printf("размер каталога: %d → %d байт\n", before, after)
+
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+}
+
+func openStore(path string) *catalog.Store {
+	store, err := catalog.Open(path)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	return store
+}
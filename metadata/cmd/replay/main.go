@@ -0,0 +1,180 @@
+// file: metadata/cmd/replay/main.go
+//
+// Заглушка внешнего API для проверки источников без сети:
+//
+//	replay -dir testdata/anilist -record https://graphql.anilist.co
+//	replay -dir testdata/anilist
+//
+// С -record запросы проксируются в указанный API, а ответы сохраняются в
+// -dir. Без него ответы отдаются только из -dir; для незаписанных запросов
+// возвращается 404. Источник направляется на заглушку через base_url
+// в конфигурации (например, ANILIST_BASE_URL=http://localhost:8099).
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fixture — записанный ответ внешнего API.
+type fixture struct {
+	Method      string          `json:"method"`
+	URL         string          `json:"url"`
+	Request     json.RawMessage `json:"request,omitempty"`
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type"`
+	// Body хранится как JSON, если ответ — JSON, иначе в Text.
+	Body json.RawMessage `json:"body,omitempty"`
+	Text string          `json:"text,omitempty"`
+}
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8099", "адрес заглушки")
+	dir := flag.String("dir", "testdata/replay", "каталог с записанными ответами")
+	record := flag.String("record", "", "базовый URL API, ответы которого нужно записать")
+	flag.Parse()
+
+	if err := os.MkdirAll(*dir, 0o755); err != nil {
+		log.Fatalf("не удалось создать каталог %s: %v", *dir, err)
+	}
+
+	h := &handler{dir: *dir, upstream: *record}
+	mode := "воспроизведение"
+	if *record != "" {
+		mode = "запись из " + *record
+	}
+	log.Printf("Заглушка слушает %s (%s, каталог %s)", *addr, mode, *dir)
+
+	if err := http.ListenAndServe(*addr, h); err != nil {
+		log.Fatalf("не удалось запустить заглушку: %v", err)
+	}
+}
+
+type handler struct {
+	dir      string
+	upstream string
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	path := filepath.Join(h.dir, fixtureKey(r.Method, r.URL, body)+".json")
+
+	var f *fixture
+	if h.upstream != "" {
+		f, err = h.fetch(r, body)
+		if err == nil {
+			err = save(path, f)
+		}
+	} else {
+		f, err = load(path)
+	}
+	if errors.Is(err, os.ErrNotExist) {
+		log.Printf("Нет записи для %s %s", r.Method, r.URL)
+		http.Error(w, "нет записанного ответа", http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		log.Printf("ошибка обработки %s %s: %v", r.Method, r.URL, err)
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", f.ContentType)
+	w.WriteHeader(f.Status)
+	if f.Body != nil {
+		w.Write(f.Body)
+	} else {
+		io.WriteString(w, f.Text)
+	}
+}
+
+func (h *handler) fetch(r *http.Request, body []byte) (*fixture, error) {
+	target := strings.TrimSuffix(h.upstream, "/") + r.URL.RequestURI()
+	req, err := http.NewRequestWithContext(r.Context(), r.Method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", r.Header.Get("Content-Type"))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Записан ответ %d для %s %s", resp.StatusCode, r.Method, r.URL.Path)
+
+	f := &fixture{
+		Method:      r.Method,
+		URL:         redact(r.URL).String(),
+		Status:      resp.StatusCode,
+		ContentType: resp.Header.Get("Content-Type"),
+	}
+	if json.Valid(respBody) {
+		f.Body = respBody
+	} else {
+		f.Text = string(respBody)
+	}
+	if json.Valid(body) {
+		f.Request = body
+	}
+	return f, nil
+}
+
+// fixtureKey идентифицирует запрос. Ключи API не учитываются, чтобы
+// записи можно было воспроизводить с любым ключом.
+func fixtureKey(method string, u *url.URL, body []byte) string {
+	sum := sha256.New()
+	io.WriteString(sum, method+" "+redact(u).RequestURI()+"\n")
+	sum.Write(body)
+	return hex.EncodeToString(sum.Sum(nil))[:32]
+}
+
+// redact возвращает копию u без секретных параметров запроса.
+func redact(u *url.URL) *url.URL {
+	clean := *u
+	query := clean.Query()
+	query.Del("api_key")
+	clean.RawQuery = query.Encode()
+	return &clean
+}
+
+func load(path string) (*fixture, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return &f, nil
+}
+
+func save(path string, f *fixture) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
@@ -2,34 +2,190 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net"
+	"os"
+	"time"
+	_ "time/tzdata" // часовые пояса для расписания на системах без базы tzdata
 
+	"github.com/waste3d/Hikari-Anime/config"
 	"github.com/waste3d/Hikari-Anime/metadata"
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	"github.com/waste3d/Hikari-Anime/metadata/catalog"
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	"github.com/waste3d/Hikari-Anime/metadata/idmap"
+	"github.com/waste3d/Hikari-Anime/metadata/images"
 	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"github.com/waste3d/Hikari-Anime/metadata/provider/anilist"
+	"github.com/waste3d/Hikari-Anime/metadata/provider/fake"
+	"github.com/waste3d/Hikari-Anime/metadata/provider/tmdb"
+	"github.com/waste3d/Hikari-Anime/metadata/searchindex"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
-const port = ":50051"
-
 func main() {
+	configPath := flag.String("config", os.Getenv(config.EnvConfigPath), "путь к JSON-файлу конфигурации")
+	printConfig := flag.Bool("print-config", false, "вывести итоговую конфигурацию и выйти")
+	flag.Parse()
 
-	lis, err := net.Listen("tcp", port)
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatalf("не удалось загрузить конфигурацию: %v", err)
+	}
+	if *printConfig {
+		if err := cfg.Dump(os.Stdout); err != nil {
+			log.Fatalf("не удалось вывести конфигурацию: %v", err)
+		}
+		return
+	}
+	if err := cfg.Metadata.Validate(); err != nil {
+		log.Fatalf("некорректная конфигурация: %v", err)
+	}
+
+	providers := newProviders(cfg.Metadata)
+	metadataProvider := providers[cfg.Metadata.Provider]
+	if cfg.Metadata.FallbackProvider != "" {
+		metadataProvider = provider.NewFallback(metadataProvider, providers[cfg.Metadata.FallbackProvider])
+	}
+
+	lis, err := net.Listen("tcp", cfg.Metadata.ListenAddr)
 	if err != nil {
 		log.Fatalf("не удалось начать прослушивание порта: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	var opts []metadata.Option
+	for name, p := range providers {
+		if name != metadataProvider.Name() {
+			opts = append(opts, metadata.WithProvider(p))
+		}
+	}
+	if path := cfg.Metadata.IDMapping.Path; path != "" {
+		ids := idmap.New()
+		n, err := ids.LoadFile(path)
+		if err != nil {
+			log.Fatalf("не удалось загрузить соответствия ID: %v", err)
+		}
+		log.Printf("Загружено %d соответствий ID из %s", n, path)
+		opts = append(opts, metadata.WithIDMapping(ids))
+	}
+	opts = append(opts, metadata.WithLanguageFallback(cfg.Metadata.Language.Fallback))
+	opts = append(opts, metadata.WithImages(newImages(providers, cfg.Metadata)))
+	if cfg.Metadata.Cache.Enabled {
+		opts = append(opts, metadata.WithCache(newCache(cfg.Metadata.Cache)))
+	}
+	if cfg.Metadata.Catalog.Enabled {
+		store, err := catalog.Open(cfg.Metadata.Catalog.Path)
+		if err != nil {
+			log.Fatalf("не удалось открыть каталог: %v", err)
+		}
+		defer store.Close()
+
+		go evictCatalog(store, cfg.Metadata.Catalog)
+		opts = append(opts, metadata.WithCatalog(store, time.Duration(cfg.Metadata.Catalog.FreshFor)))
+	}
+	if cfg.Metadata.SearchIndex.Enabled {
+		opts = append(opts, metadata.WithSearchIndex(searchindex.New(cfg.Metadata.SearchIndex.MaxTitles)))
+	}
+	metadataServer := metadata.NewServer(metadataProvider, opts...)
+	go func() {
+		if err := metadataServer.IndexCatalog(); err != nil {
+			log.Printf("не удалось добавить каталог в поисковый индекс: %v", err)
+		}
+	}()
 
-	metadataServer := metadata.NewServer()
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		metadataServer.ErrorInterceptor(),
+		metadataServer.ProviderInterceptor(),
+		metadataServer.ImageInterceptor(),
+		metadataServer.CacheInterceptor(),
+		metadataServer.CatalogInterceptor(),
+		metadataServer.SearchIndexInterceptor(),
+	))
 
 	pb.RegisterMetadataServiceServer(grpcServer, metadataServer)
 	reflection.Register(grpcServer)
 
-	log.Printf("Сервер запущен и слушает порт %v", lis.Addr())
+	log.Printf("Сервер запущен и слушает порт %v (источник: %s)", lis.Addr(), metadataProvider.Name())
 
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("не удалось запустить сервер: %v", err)
 	}
 }
+
+// newProviders создаёт все источники, доступные с этой конфигурацией:
+// AniList всегда, TMDb — если задан ключ, тестовый — только если он
+// выбран основным или запасным.
+func newProviders(cfg config.MetadataConfig) map[string]provider.Provider {
+	client := newHTTPClient(cfg.HTTP)
+
+	providers := map[string]provider.Provider{
+		"anilist": anilist.New(cfg.AniList.BaseURL, client),
+	}
+	if cfg.TMDb.APIKey != "" {
+		providers["tmdb"] = tmdb.New(cfg.TMDb.APIKey, cfg.TMDb.BaseURL, client)
+	}
+	if cfg.Provider == "fake" || cfg.FallbackProvider == "fake" {
+		providers["fake"] = fake.New()
+	}
+	return providers
+}
+
+// newImages создаёт построитель адресов изображений. Конфигурация берётся
+// из TMDb, если он доступен, иначе используется стандартная.
+func newImages(providers map[string]provider.Provider, cfg config.MetadataConfig) *images.Builder {
+	var source images.Source
+	if p, ok := providers["tmdb"].(*tmdb.Provider); ok {
+		source = p.ImageConfig
+	}
+	b := images.New(source, cfg.Images.DefaultSizes, cfg.Images.BaseURL)
+
+	if pc := cfg.Images.Placeholders; pc.Enabled {
+		client := newHTTPClient(cfg.HTTP)
+		b.WithPlaceholders(images.NewPlaceholders(client.Get, pc.Workers, pc.MaxEntries))
+	}
+	return b
+}
+
+func newHTTPClient(cfg config.HTTPConfig) *httpclient.Client {
+	return httpclient.New(httpclient.Config{
+		Timeout:       time.Duration(cfg.Timeout),
+		MaxRetries:    cfg.MaxRetries,
+		BackoffBase:   time.Duration(cfg.BackoffBase),
+		BackoffMax:    time.Duration(cfg.BackoffMax),
+		MaxConcurrent: cfg.MaxConcurrent,
+	})
+}
+
+func newCache(cfg config.CacheConfig) *cache.Cache {
+	ttl := make(map[string]time.Duration, len(cfg.TTL))
+	for rpc, d := range cfg.TTL {
+		ttl[rpc] = time.Duration(d)
+	}
+
+	return cache.New(cache.Config{
+		MaxEntries: cfg.MaxEntries,
+		MaxBytes:   cfg.MaxBytes,
+		DefaultTTL: time.Duration(cfg.DefaultTTL),
+		TTL:        ttl,
+	})
+}
+
+// evictCatalog периодически удаляет из каталога записи старше Retention.
+func evictCatalog(store *catalog.Store, cfg config.CatalogConfig) {
+	ticker := time.NewTicker(time.Duration(cfg.EvictInterval))
+	defer ticker.Stop()
+
+	for range ticker.C {
+		evicted, err := store.EvictOlderThan(time.Duration(cfg.Retention))
+		if err != nil {
+			log.Printf("ошибка очистки каталога: %v", err)
+			continue
+		}
+		if evicted > 0 {
+			log.Printf("Из каталога удалено %d устаревших записей", evicted)
+		}
+	}
+}
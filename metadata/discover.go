@@ -0,0 +1,106 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// minDiscoverYear — год, раньше которого фильмов не бывает: первые
+// короткометражки сняты в 1888 году.
+const minDiscoverYear = 1888
+
+func (s *Server) Discover(ctx context.Context, req *pb.DiscoverRequest) (*pb.DiscoverResponse, error) {
+	if err := validateDiscover(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	query := provider.DiscoverQuery{
+		Page:             max(req.GetPage(), 1),
+		Language:         req.GetLanguage(),
+		SortBy:           req.GetSortBy(),
+		Genres:           req.GetWithGenres(),
+		ExcludeGenres:    req.GetWithoutGenres(),
+		OriginalLanguage: req.GetOriginalLanguage(),
+		MinRating:        req.GetMinRating(),
+		MinVotes:         req.GetMinVotes(),
+		MinRuntime:       req.GetMinRuntime(),
+		MaxRuntime:       req.GetMaxRuntime(),
+	}
+	if req.GetYearFrom() != 0 {
+		query.ReleasedFrom = fmt.Sprintf("%04d-01-01", req.GetYearFrom())
+	}
+	if req.GetYearTo() != 0 {
+		query.ReleasedTo = fmt.Sprintf("%04d-12-31", req.GetYearTo())
+	}
+
+	page, err := s.providerFor(ctx).Discover(ctx, req.GetMediaType(), query)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DiscoverResponse{
+		Results:      page.Results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}, nil
+}
+
+// validateDiscover проверяет значения фильтров и их сочетания.
+func validateDiscover(req *pb.DiscoverRequest) error {
+	if req.GetMediaType() != pb.MediaType_MEDIA_TYPE_MOVIE && req.GetMediaType() != pb.MediaType_MEDIA_TYPE_TV {
+		return errors.New("media_type должен быть фильмом или сериалом")
+	}
+	for _, g := range slices.Concat(req.GetWithGenres(), req.GetWithoutGenres()) {
+		if g <= 0 {
+			return fmt.Errorf("некорректный ID жанра: %d", g)
+		}
+	}
+	for _, g := range req.GetWithGenres() {
+		if slices.Contains(req.GetWithoutGenres(), g) {
+			return fmt.Errorf("жанр %d одновременно в with_genres и without_genres", g)
+		}
+	}
+	for _, year := range []int32{req.GetYearFrom(), req.GetYearTo()} {
+		if year != 0 && year < minDiscoverYear {
+			return fmt.Errorf("некорректный год: %d", year)
+		}
+	}
+	if req.GetYearFrom() != 0 && req.GetYearTo() != 0 && req.GetYearFrom() > req.GetYearTo() {
+		return fmt.Errorf("year_from (%d) больше year_to (%d)", req.GetYearFrom(), req.GetYearTo())
+	}
+	if req.GetMinRating() < 0 || req.GetMinRating() > 10 {
+		return errors.New("min_rating должен быть от 0 до 10")
+	}
+	if req.GetMinVotes() < 0 {
+		return errors.New("min_votes не может быть отрицательным")
+	}
+	if req.GetMinRuntime() < 0 || req.GetMaxRuntime() < 0 {
+		return errors.New("длительность не может быть отрицательной")
+	}
+	if req.GetMaxRuntime() != 0 && req.GetMinRuntime() > req.GetMaxRuntime() {
+		return fmt.Errorf("min_runtime (%d) больше max_runtime (%d)", req.GetMinRuntime(), req.GetMaxRuntime())
+	}
+	if l := req.GetOriginalLanguage(); l != "" && !isLanguageCode(l) {
+		return errors.New("original_language должен быть кодом ISO 639-1, например \"ja\"")
+	}
+	return nil
+}
+
+func isLanguageCode(code string) bool {
+	if len(code) != 2 {
+		return false
+	}
+	for _, r := range code {
+		if r < 'a' || r > 'z' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,98 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path"
+
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// errorDomain — домен в google.rpc.ErrorInfo для ошибок сервиса.
+const errorDomain = "metadata.hikari"
+
+// ErrorInterceptor возвращает серверный перехватчик, который переводит
+// ошибки источников в gRPC-статусы с деталями google.rpc.ErrorInfo
+// (и google.rpc.RetryInfo для превышения лимита запросов).
+func (s *Server) ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		st := toStatus(err)
+		if st.Code() != codes.InvalidArgument {
+			log.Printf("ошибка в %s: %v", path.Base(info.FullMethod), err)
+		}
+		return nil, st.Err()
+	}
+}
+
+// toStatus переводит ошибку в gRPC-статус. Ошибки, уже являющиеся
+// статусом, возвращаются как есть.
+func toStatus(err error) *status.Status {
+	if st, ok := status.FromError(err); ok {
+		return st
+	}
+
+	// Ошибка источника проверяется первой: таймаут запроса к нему тоже
+	// оборачивает context.DeadlineExceeded, но должен сохранить детали.
+	var providerErr *provider.Error
+	if !errors.As(err, &providerErr) {
+		switch {
+		case errors.Is(err, context.Canceled):
+			return status.New(codes.Canceled, err.Error())
+		case errors.Is(err, context.DeadlineExceeded):
+			return status.New(codes.DeadlineExceeded, err.Error())
+		}
+		return status.New(codes.Internal, err.Error())
+	}
+
+	st := status.New(providerCode(providerErr.Kind), err.Error())
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+		Reason:   providerErr.Kind.String(),
+		Domain:   errorDomain,
+		Metadata: map[string]string{"provider": providerErr.Provider},
+	}}
+	if providerErr.RetryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(providerErr.RetryAfter)})
+	}
+
+	withDetails, detailsErr := st.WithDetails(details...)
+	if detailsErr != nil {
+		return st
+	}
+	return withDetails
+}
+
+// statusCode возвращает gRPC-код, которым ErrorInterceptor отметит err.
+func statusCode(err error) codes.Code {
+	return toStatus(err).Code()
+}
+
+func providerCode(kind provider.ErrorKind) codes.Code {
+	switch kind {
+	case provider.KindNotFound:
+		return codes.NotFound
+	case provider.KindUnauthorized:
+		return codes.Unauthenticated
+	case provider.KindRateLimited:
+		return codes.ResourceExhausted
+	case provider.KindTimeout:
+		return codes.DeadlineExceeded
+	case provider.KindMalformed:
+		return codes.Internal
+	case provider.KindUnsupported:
+		return codes.Unimplemented
+	default:
+		return codes.Unavailable
+	}
+}
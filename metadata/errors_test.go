@@ -0,0 +1,88 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	upstream := func(code int) error {
+		return provider.Classify("tmdb", &httpclient.StatusError{StatusCode: code, Status: http.StatusText(code), RetryAfter: 3 * time.Second})
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		want       codes.Code
+		wantReason string
+		wantRetry  time.Duration
+	}{
+		{name: "404 источника", err: upstream(http.StatusNotFound), want: codes.NotFound, wantReason: "UPSTREAM_NOT_FOUND"},
+		{name: "401 источника", err: upstream(http.StatusUnauthorized), want: codes.Unauthenticated, wantReason: "UPSTREAM_UNAUTHORIZED"},
+		{name: "403 источника", err: upstream(http.StatusForbidden), want: codes.Unauthenticated, wantReason: "UPSTREAM_UNAUTHORIZED"},
+		{name: "429 источника", err: upstream(http.StatusTooManyRequests), want: codes.ResourceExhausted, wantReason: "UPSTREAM_RATE_LIMITED", wantRetry: 3 * time.Second},
+		{name: "504 источника", err: upstream(http.StatusGatewayTimeout), want: codes.DeadlineExceeded, wantReason: "UPSTREAM_TIMEOUT"},
+		{name: "500 источника", err: upstream(http.StatusInternalServerError), want: codes.Unavailable, wantReason: "UPSTREAM_UNAVAILABLE"},
+		{name: "502 источника", err: upstream(http.StatusBadGateway), want: codes.Unavailable, wantReason: "UPSTREAM_UNAVAILABLE"},
+		{
+			name: "таймаут запроса к источнику",
+			err:  provider.Classify("tmdb", fmt.Errorf("ошибка при выполнении запроса: %w", context.DeadlineExceeded)),
+			want: codes.DeadlineExceeded, wantReason: "UPSTREAM_TIMEOUT",
+		},
+		{
+			name: "неразборчивый ответ",
+			err:  provider.NewError("anilist", provider.KindMalformed, errors.New("ожидался JSON")),
+			want: codes.Internal, wantReason: "UPSTREAM_MALFORMED_RESPONSE",
+		},
+		{
+			name: "неподдерживаемый запрос",
+			err:  fmt.Errorf("сезон: %w", provider.Unsupported("anilist", "сезон")),
+			want: codes.Unimplemented, wantReason: "UPSTREAM_UNSUPPORTED",
+		},
+		{name: "отмена клиентом", err: provider.Classify("tmdb", fmt.Errorf("запрос: %w", context.Canceled)), want: codes.Canceled},
+		{name: "дедлайн RPC", err: context.DeadlineExceeded, want: codes.DeadlineExceeded},
+		{name: "готовый статус не меняется", err: status.Error(codes.InvalidArgument, "нет запроса"), want: codes.InvalidArgument},
+		{name: "прочие ошибки", err: errors.New("что-то сломалось"), want: codes.Internal},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := toStatus(tt.err)
+			if st.Code() != tt.want {
+				t.Errorf("код = %v, want %v", st.Code(), tt.want)
+			}
+
+			var reason, providerName string
+			var retry time.Duration
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.ErrorInfo:
+					reason, providerName = d.GetReason(), d.GetMetadata()["provider"]
+					if d.GetDomain() != errorDomain {
+						t.Errorf("Domain = %q, want %q", d.GetDomain(), errorDomain)
+					}
+				case *errdetails.RetryInfo:
+					retry = d.GetRetryDelay().AsDuration()
+				}
+			}
+			if reason != tt.wantReason {
+				t.Errorf("Reason = %q, want %q", reason, tt.wantReason)
+			}
+			if tt.wantReason != "" && providerName == "" {
+				t.Error("в ErrorInfo нет источника")
+			}
+			if retry != tt.wantRetry {
+				t.Errorf("RetryDelay = %s, want %s", retry, tt.wantRetry)
+			}
+		})
+	}
+}
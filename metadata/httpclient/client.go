@@ -0,0 +1,204 @@
+// Package httpclient содержит HTTP-клиент для обращений к внешним API
+// источников метаданных: с учётом контекста RPC, повторами и ограничением
+// числа одновременных соединений.
+package httpclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Config struct {
+	// Timeout ограничивает одну попытку запроса.
+	Timeout time.Duration
+	// MaxRetries — сколько раз повторять запрос после первой неудачи.
+	MaxRetries int
+	// BackoffBase и BackoffMax задают экспоненциальную задержку между
+	// попытками: случайное значение от 0 до min(BackoffMax, BackoffBase*2^n).
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+	// MaxConcurrent ограничивает число одновременных запросов; 0 — без ограничения.
+	MaxConcurrent int
+}
+
+// StatusError — ответ API с кодом, отличным от 200 OK.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	// RetryAfter — значение заголовка Retry-After, если он был.
+	RetryAfter time.Duration
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("API вернул ошибку: %s", e.Status)
+}
+
+type Client struct {
+	cfg  Config
+	http *http.Client
+	sem  chan struct{}
+}
+
+func New(cfg Config) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxConnsPerHost = cfg.MaxConcurrent
+	transport.MaxIdleConnsPerHost = max(cfg.MaxConcurrent, http.DefaultMaxIdleConnsPerHost)
+
+	c := &Client{
+		cfg:  cfg,
+		http: &http.Client{Transport: transport},
+	}
+	if cfg.MaxConcurrent > 0 {
+		c.sem = make(chan struct{}, cfg.MaxConcurrent)
+	}
+	return c
+}
+
+// Get выполняет GET-запрос и возвращает тело ответа 200 OK. Сетевые
+// ошибки, 429 и 5xx повторяются; при 429 задержка берётся из Retry-After.
+// Ожидание прерывается при отмене ctx или если до его дедлайна не успеть.
+func (c *Client) Get(ctx context.Context, url string) ([]byte, error) {
+	return c.retry(ctx, http.MethodGet, url, "", nil)
+}
+
+// Post отправляет body с типом contentType и повторяет запрос так же, как
+// Get. Подходит только для идемпотентных запросов, например GraphQL query.
+func (c *Client) Post(ctx context.Context, url, contentType string, body []byte) ([]byte, error) {
+	return c.retry(ctx, http.MethodPost, url, contentType, body)
+}
+
+func (c *Client) retry(ctx context.Context, method, url, contentType string, reqBody []byte) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		body, err := c.do(ctx, method, url, contentType, reqBody)
+		if err == nil {
+			return body, nil
+		}
+		if attempt >= c.cfg.MaxRetries || !retryable(ctx, err) {
+			return nil, err
+		}
+
+		delay := c.backoff(attempt)
+		var statusErr *StatusError
+		if errors.As(err, &statusErr) && statusErr.RetryAfter > 0 {
+			delay = statusErr.RetryAfter
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return nil, err
+		}
+
+		log.Printf("Повторяю запрос через %s (попытка %d из %d): %v", delay, attempt+2, c.cfg.MaxRetries+1, err)
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (c *Client) do(ctx context.Context, method, url, contentType string, reqBody []byte) ([]byte, error) {
+	if c.sem != nil {
+		select {
+		case c.sem <- struct{}{}:
+			defer func() { <-c.sem }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+
+	var bodyReader io.Reader
+	if reqBody != nil {
+		bodyReader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при выполнении запроса: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		// Дочитываем тело, чтобы соединение вернулось в пул.
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return nil, &StatusError{
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ошибка при чтении ответа: %w", err)
+	}
+	return body, nil
+}
+
+func (c *Client) backoff(attempt int) time.Duration {
+	limit := c.cfg.BackoffBase << attempt
+	if limit <= 0 || limit > c.cfg.BackoffMax {
+		limit = c.cfg.BackoffMax
+	}
+	if limit <= 0 {
+		return 0
+	}
+	return rand.N(limit)
+}
+
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var statusErr *StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.StatusCode == http.StatusTooManyRequests || statusErr.StatusCode >= 500
+	}
+	// Сетевые ошибки и таймаут отдельной попытки.
+	return true
+}
+
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return time.Until(at)
+	}
+	return 0
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
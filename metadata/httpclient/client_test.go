@@ -0,0 +1,227 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// stub запускает сервер, который отвечает кодами из statuses по очереди,
+// повторяя последний, и возвращает его адрес и счётчик запросов.
+func stub(t *testing.T, header http.Header, statuses ...int) (string, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(calls.Add(1))
+		status := statuses[min(n, len(statuses))-1]
+		for k, v := range header {
+			w.Header()[k] = v
+		}
+		w.WriteHeader(status)
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv.URL, &calls
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		maxRetries int
+		wantCalls  int32
+		wantStatus int
+	}{
+		{name: "успех с первой попытки", statuses: []int{200}, maxRetries: 2, wantCalls: 1},
+		{name: "5xx повторяется", statuses: []int{503, 502, 200}, maxRetries: 2, wantCalls: 3},
+		{name: "429 повторяется", statuses: []int{429, 200}, maxRetries: 2, wantCalls: 2},
+		{name: "попытки кончились", statuses: []int{500}, maxRetries: 2, wantCalls: 3, wantStatus: 500},
+		{name: "без повторов", statuses: []int{503}, wantCalls: 1, wantStatus: 503},
+		{name: "404 не повторяется", statuses: []int{404}, maxRetries: 2, wantCalls: 1, wantStatus: 404},
+		{name: "401 не повторяется", statuses: []int{401}, maxRetries: 2, wantCalls: 1, wantStatus: 401},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, calls := stub(t, nil, tt.statuses...)
+			c := New(Config{MaxRetries: tt.maxRetries, BackoffBase: time.Millisecond, BackoffMax: time.Millisecond})
+
+			body, err := c.Get(context.Background(), url)
+			if got := calls.Load(); got != tt.wantCalls {
+				t.Errorf("запросов = %d, want %d", got, tt.wantCalls)
+			}
+			if tt.wantStatus == 0 {
+				if err != nil || string(body) != "ok" {
+					t.Errorf("Get = %q, %v", body, err)
+				}
+				return
+			}
+			var statusErr *StatusError
+			if !errors.As(err, &statusErr) || statusErr.StatusCode != tt.wantStatus {
+				t.Errorf("ошибка = %v, want StatusError %d", err, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	header := http.Header{"Retry-After": {"1"}}
+
+	t.Run("задержка из заголовка вместо backoff", func(t *testing.T) {
+		url, calls := stub(t, header, 429, 200)
+		c := New(Config{MaxRetries: 1, BackoffBase: time.Hour, BackoffMax: time.Hour})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		start := time.Now()
+		if _, err := c.Get(ctx, url); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed < time.Second || elapsed > 3*time.Second {
+			t.Errorf("повтор через %s, want ~1s", elapsed)
+		}
+		if calls.Load() != 2 {
+			t.Errorf("запросов = %d, want 2", calls.Load())
+		}
+	})
+
+	t.Run("не ждёт дольше дедлайна", func(t *testing.T) {
+		url, calls := stub(t, header, 429, 200)
+		c := New(Config{MaxRetries: 1})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := c.Get(ctx, url)
+
+		var statusErr *StatusError
+		if !errors.As(err, &statusErr) || statusErr.RetryAfter != time.Second {
+			t.Fatalf("ошибка = %v, want 429 с RetryAfter 1s", err)
+		}
+		if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+			t.Errorf("ошибка отдана через %s, want сразу", elapsed)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("запросов = %d, want 1", calls.Load())
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		value    string
+		min, max time.Duration
+	}{
+		{"", 0, 0},
+		{"3", 3 * time.Second, 3 * time.Second},
+		{"0", 0, 0},
+		{"-5", 0, 0},
+		{"скоро", 0, 0},
+		{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 58 * time.Second, time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %s, want [%s, %s]", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name      string
+		cfg       Config
+		attempt   int
+		wantLimit time.Duration
+	}{
+		{name: "первая попытка", cfg: Config{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}, wantLimit: 100 * time.Millisecond},
+		{name: "рост вдвое", cfg: Config{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}, attempt: 2, wantLimit: 400 * time.Millisecond},
+		{name: "потолок", cfg: Config{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}, attempt: 5, wantLimit: time.Second},
+		{name: "переполнение сдвига", cfg: Config{BackoffBase: 100 * time.Millisecond, BackoffMax: time.Second}, attempt: 70, wantLimit: time.Second},
+		{name: "без задержки", cfg: Config{}, attempt: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(tt.cfg)
+			for range 1000 {
+				got := c.backoff(tt.attempt)
+				if got < 0 || got > tt.wantLimit || tt.wantLimit > 0 && got == tt.wantLimit {
+					t.Fatalf("backoff(%d) = %s, want [0, %s)", tt.attempt, got, tt.wantLimit)
+				}
+			}
+		})
+	}
+}
+
+func TestCancelStopsRetries(t *testing.T) {
+	url, calls := stub(t, nil, 503)
+	c := New(Config{MaxRetries: 10, BackoffBase: time.Hour, BackoffMax: time.Hour})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start := time.Now()
+	_, err := c.Get(ctx, url)
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ошибка = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("ожидание повтора не прервано: %s", elapsed)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("запросов = %d, want 1", calls.Load())
+	}
+}
+
+func TestMaxConcurrent(t *testing.T) {
+	const limit = 2
+
+	var inFlight, peak atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		<-release
+	}))
+	defer srv.Close()
+
+	c := New(Config{MaxConcurrent: limit})
+
+	var wg sync.WaitGroup
+	for range 2 * limit {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(context.Background(), srv.URL); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	// Пока заняты все слоты, ожидающий запрос прерывается своим контекстом.
+	for inFlight.Load() < limit {
+		time.Sleep(time.Millisecond)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := c.Get(ctx, srv.URL); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("ожидание слота: %v, want context.DeadlineExceeded", err)
+	}
+
+	close(release)
+	wg.Wait()
+	if got := peak.Load(); got != limit {
+		t.Errorf("одновременных запросов = %d, want %d", got, limit)
+	}
+}
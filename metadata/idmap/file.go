@@ -0,0 +1,98 @@
+package idmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// record — запись офлайн-файла соответствий. Формат совпадает с
+// anime-lists (anime-list-full.json): массив объектов с полями
+// <источник>_id, числовыми или строковыми, и типом тайтла.
+type record struct {
+	Type      string     `json:"type"`
+	TMDb      flexibleID `json:"themoviedb_id"`
+	AniList   flexibleID `json:"anilist_id"`
+	MAL       flexibleID `json:"mal_id"`
+	Shikimori flexibleID `json:"shikimori_id"`
+	IMDb      flexibleID `json:"imdb_id"`
+	TVDB      flexibleID `json:"thetvdb_id"`
+	Kitsu     flexibleID `json:"kitsu_id"`
+}
+
+// flexibleID принимает ID и как число, и как строку.
+type flexibleID string
+
+func (id *flexibleID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if n, err := strconv.ParseInt(string(data), 10, 64); err == nil {
+		*id = flexibleID(strconv.FormatInt(n, 10))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("ID должен быть числом или строкой: %s", data)
+	}
+	*id = flexibleID(s)
+	return nil
+}
+
+// LoadFile загружает соответствия из JSON-файла path и возвращает число
+// прочитанных записей.
+func (m *Mapping) LoadFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("не удалось открыть файл соответствий ID: %w", err)
+	}
+	defer f.Close()
+
+	n, err := m.Load(f)
+	if err != nil {
+		return n, fmt.Errorf("ошибка в файле соответствий ID %s: %w", path, err)
+	}
+	return n, nil
+}
+
+// Load загружает соответствия из r (формат описан у record).
+func (m *Mapping) Load(r io.Reader) (int, error) {
+	dec := json.NewDecoder(r)
+	if tok, err := dec.Token(); err != nil {
+		return 0, err
+	} else if tok != json.Delim('[') {
+		return 0, fmt.Errorf("ожидался JSON-массив записей")
+	}
+
+	n := 0
+	for dec.More() {
+		var rec record
+		if err := dec.Decode(&rec); err != nil {
+			return n, fmt.Errorf("запись %d: %w", n+1, err)
+		}
+		m.Add(rec.mediaType(), map[string]string{
+			TMDb:      string(rec.TMDb),
+			AniList:   string(rec.AniList),
+			MAL:       string(rec.MAL),
+			Shikimori: string(rec.Shikimori),
+			IMDb:      string(rec.IMDb),
+			TVDB:      string(rec.TVDB),
+			Kitsu:     string(rec.Kitsu),
+		})
+		n++
+	}
+	return n, nil
+}
+
+// mediaType переводит тип AniDB/AniList (TV, MOVIE, OVA, ...) в MediaType.
+func (r record) mediaType() pb.MediaType {
+	if strings.EqualFold(r.Type, "MOVIE") {
+		return pb.MediaType_MEDIA_TYPE_MOVIE
+	}
+	return pb.MediaType_MEDIA_TYPE_TV
+}
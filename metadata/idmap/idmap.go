@@ -0,0 +1,213 @@
+// Package idmap хранит соответствия ID одного тайтла в разных источниках
+// (TMDb, AniList, MyAnimeList, Shikimori и других).
+//
+// Соответствия загружаются из офлайн-файла (LoadFile) и пополняются
+// по ходу работы из внешних ID, которые источники отдают в TitleDetails.
+package idmap
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// Пространства имён ID.
+const (
+	TMDb      = "tmdb"
+	AniList   = "anilist"
+	MAL       = "mal"
+	Shikimori = "shikimori"
+	IMDb      = "imdb"
+	TVDB      = "tvdb"
+	Kitsu     = "kitsu"
+)
+
+// namespaceOrder — порядок, в котором Add ищет уже известные ID тайтла:
+// от источников, чьи ID точнее всего указывают на тайтл, к остальным.
+// Пространства имён не из списка проверяются последними по алфавиту.
+var namespaceOrder = []string{TMDb, AniList, MAL, Shikimori, Kitsu, TVDB, IMDb}
+
+// ID — идентификатор тайтла в пространстве имён источника.
+type ID struct {
+	Namespace string
+	Value     string
+}
+
+func (id ID) String() string {
+	return id.Namespace + ":" + id.Value
+}
+
+// Int возвращает числовое значение ID (у IMDb ID не числовые).
+func (id ID) Int() (int64, error) {
+	n, err := strconv.ParseInt(id.Value, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("ID %s не является положительным числом", id)
+	}
+	return n, nil
+}
+
+// Parse разбирает ID вида "anilist:21".
+func Parse(s string) (ID, error) {
+	namespace, value, ok := strings.Cut(s, ":")
+	namespace = strings.ToLower(strings.TrimSpace(namespace))
+	value = strings.TrimSpace(value)
+	if !ok || namespace == "" || value == "" {
+		return ID{}, fmt.Errorf("ID %q должен иметь вид <источник>:<id>, например anilist:21", s)
+	}
+	return ID{Namespace: namespace, Value: value}, nil
+}
+
+// Entry — все известные ID одного тайтла.
+type Entry struct {
+	MediaType pb.MediaType
+	// IDs — пространство имён → ID.
+	IDs map[string]string
+}
+
+// Mapping — потокобезопасный набор соответствий ID.
+type Mapping struct {
+	mu    sync.RWMutex
+	index map[string]*Entry
+	size  int
+}
+
+func New() *Mapping {
+	return &Mapping{index: make(map[string]*Entry)}
+}
+
+// Len возвращает число тайтлов в наборе.
+func (m *Mapping) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.size
+}
+
+// Add добавляет соответствие ID тайтла типа mediaType. Если какой-то из
+// ids уже известен, новые ID дописываются к существующей записи. Если
+// ids связывают несколько записей, они сливаются в первую найденную в
+// порядке namespaceOrder; при расхождении остаются её ID.
+//
+// Shikimori использует ID MyAnimeList, поэтому при наличии ID MAL ID
+// Shikimori заполняется автоматически.
+func (m *Mapping) Add(mediaType pb.MediaType, ids map[string]string) {
+	ids = maps.Clone(ids)
+	for ns, value := range ids {
+		if value == "" || value == "0" {
+			delete(ids, ns)
+		}
+	}
+	if mal, ok := ids[MAL]; ok && ids[Shikimori] == "" {
+		ids[Shikimori] = mal
+	}
+	if len(ids) < 2 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	namespaces := slices.SortedFunc(maps.Keys(ids), compareNamespaces)
+
+	var entry *Entry
+	for _, ns := range namespaces {
+		e, ok := m.index[indexKey(mediaType, ns, ids[ns])]
+		switch {
+		case !ok || e == entry:
+		case entry == nil:
+			entry = e
+		default:
+			m.merge(entry, e)
+		}
+	}
+	if entry == nil {
+		entry = &Entry{MediaType: mediaType, IDs: make(map[string]string, len(ids))}
+		m.size++
+	}
+
+	for _, ns := range namespaces {
+		if _, ok := entry.IDs[ns]; ok {
+			continue
+		}
+		entry.IDs[ns] = ids[ns]
+		m.index[indexKey(mediaType, ns, ids[ns])] = entry
+	}
+}
+
+// merge переносит ID записи from в into и направляет на into все ключи
+// индекса from. Вызывается под m.mu.
+func (m *Mapping) merge(into, from *Entry) {
+	for ns, value := range from.IDs {
+		if _, ok := into.IDs[ns]; !ok {
+			into.IDs[ns] = value
+		}
+		m.index[indexKey(from.MediaType, ns, value)] = into
+	}
+	m.size--
+}
+
+// compareNamespaces упорядочивает пространства имён по namespaceOrder.
+func compareNamespaces(a, b string) int {
+	rank := func(ns string) int {
+		if i := slices.Index(namespaceOrder, ns); i >= 0 {
+			return i
+		}
+		return len(namespaceOrder)
+	}
+	return cmp.Or(cmp.Compare(rank(a), rank(b)), strings.Compare(a, b))
+}
+
+// Resolve возвращает копию записи, в которой есть id. Для ID TMDb с
+// неизвестным mediaType сначала ищется фильм, затем сериал.
+func (m *Mapping) Resolve(id ID, mediaType pb.MediaType) (Entry, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	candidates := []pb.MediaType{mediaType}
+	if mediaType == pb.MediaType_MEDIA_TYPE_UNSPECIFIED {
+		candidates = []pb.MediaType{pb.MediaType_MEDIA_TYPE_MOVIE, pb.MediaType_MEDIA_TYPE_TV}
+	}
+	for _, t := range candidates {
+		if e, ok := m.index[indexKey(t, id.Namespace, id.Value)]; ok {
+			return Entry{MediaType: e.MediaType, IDs: maps.Clone(e.IDs)}, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Learn запоминает внешние ID из details и дополняет details.ExternalIds
+// известными соответствиями.
+func (m *Mapping) Learn(details *pb.TitleDetails) {
+	if details == nil || len(details.GetExternalIds()) == 0 {
+		return
+	}
+	m.Add(details.GetMediaType(), details.GetExternalIds())
+
+	for ns, value := range details.GetExternalIds() {
+		entry, ok := m.Resolve(ID{Namespace: ns, Value: value}, details.GetMediaType())
+		if !ok {
+			continue
+		}
+		for ns, value := range entry.IDs {
+			if _, ok := details.ExternalIds[ns]; !ok {
+				details.ExternalIds[ns] = value
+			}
+		}
+		return
+	}
+}
+
+// indexKey строит ключ индекса. ID TMDb уникальны только в пределах
+// типа, поэтому тип входит в ключ; тайтлы остальных источников
+// нумеруются сквозным образом.
+func indexKey(mediaType pb.MediaType, namespace, value string) string {
+	if namespace == TMDb {
+		return namespace + ":" + mediaType.String() + ":" + value
+	}
+	return namespace + ":" + value
+}
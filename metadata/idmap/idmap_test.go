@@ -0,0 +1,212 @@
+package idmap
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+const (
+	movie = pb.MediaType_MEDIA_TYPE_MOVIE
+	tv    = pb.MediaType_MEDIA_TYPE_TV
+)
+
+// addition — один вызов Add.
+type addition struct {
+	mediaType pb.MediaType
+	ids       map[string]string
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name    string
+		adds    []addition
+		resolve ID
+		// want — ID записи, в которой есть resolve; nil — записи нет.
+		want    map[string]string
+		wantLen int
+	}{
+		{
+			name:    "MAL копируется в Shikimori",
+			adds:    []addition{{tv, map[string]string{AniList: "16498", MAL: "16498"}}},
+			resolve: ID{Shikimori, "16498"},
+			want:    map[string]string{AniList: "16498", MAL: "16498", Shikimori: "16498"},
+			wantLen: 1,
+		},
+		{
+			name:    "ID Shikimori из источника не заменяется",
+			adds:    []addition{{tv, map[string]string{MAL: "1", Shikimori: "z1"}}},
+			resolve: ID{MAL, "1"},
+			want:    map[string]string{MAL: "1", Shikimori: "z1"},
+			wantLen: 1,
+		},
+		{
+			name:    "одного MAL достаточно для соответствия",
+			adds:    []addition{{tv, map[string]string{MAL: "5114"}}},
+			resolve: ID{Shikimori, "5114"},
+			want:    map[string]string{MAL: "5114", Shikimori: "5114"},
+			wantLen: 1,
+		},
+		{
+			name:    "пустые и нулевые ID отбрасываются, одного ID мало",
+			adds:    []addition{{tv, map[string]string{AniList: "21", TMDb: "0", Kitsu: ""}}},
+			resolve: ID{AniList, "21"},
+		},
+		{
+			name: "новые ID дописываются к известной записи",
+			adds: []addition{
+				{tv, map[string]string{TMDb: "1429", AniList: "16498"}},
+				{tv, map[string]string{AniList: "16498", IMDb: "tt2560140"}},
+			},
+			resolve: ID{IMDb, "tt2560140"},
+			want:    map[string]string{TMDb: "1429", AniList: "16498", IMDb: "tt2560140"},
+			wantLen: 1,
+		},
+		{
+			name: "при расхождении остаётся известный ID",
+			adds: []addition{
+				{tv, map[string]string{TMDb: "1429", AniList: "16498"}},
+				{tv, map[string]string{TMDb: "1429", AniList: "20958"}},
+			},
+			resolve: ID{TMDb, "1429"},
+			want:    map[string]string{TMDb: "1429", AniList: "16498"},
+			wantLen: 1,
+		},
+		{
+			name: "связанные записи сливаются",
+			adds: []addition{
+				{tv, map[string]string{TMDb: "1429", Kitsu: "7442"}},
+				{tv, map[string]string{AniList: "16498", MAL: "16498"}},
+				{tv, map[string]string{Kitsu: "7442", AniList: "16498"}},
+			},
+			resolve: ID{Kitsu, "7442"},
+			want:    map[string]string{TMDb: "1429", Kitsu: "7442", AniList: "16498", MAL: "16498", Shikimori: "16498"},
+			wantLen: 1,
+		},
+		{
+			// AniList в namespaceOrder раньше IMDb, поэтому при расхождении
+			// остаются ID записи, найденной по AniList.
+			name: "при слиянии побеждает запись, найденная первой по namespaceOrder",
+			adds: []addition{
+				{tv, map[string]string{AniList: "20958", IMDb: "tt0000001"}},
+				{tv, map[string]string{TMDb: "1429", IMDb: "tt2560140"}},
+				{tv, map[string]string{IMDb: "tt2560140", AniList: "20958"}},
+			},
+			resolve: ID{IMDb, "tt2560140"},
+			want:    map[string]string{TMDb: "1429", IMDb: "tt0000001", AniList: "20958"},
+			wantLen: 1,
+		},
+		{
+			name: "ID TMDb фильма и сериала — разные тайтлы",
+			adds: []addition{
+				{movie, map[string]string{TMDb: "1", AniList: "100"}},
+				{tv, map[string]string{TMDb: "1", AniList: "200"}},
+			},
+			resolve: ID{AniList, "200"},
+			want:    map[string]string{TMDb: "1", AniList: "200"},
+			wantLen: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			for _, a := range tt.adds {
+				m.Add(a.mediaType, a.ids)
+			}
+			if m.Len() != tt.wantLen {
+				t.Errorf("Len = %d, want %d", m.Len(), tt.wantLen)
+			}
+
+			entry, ok := m.Resolve(tt.resolve, pb.MediaType_MEDIA_TYPE_UNSPECIFIED)
+			if tt.want == nil {
+				if ok {
+					t.Errorf("Resolve(%s) = %v, want нет записи", tt.resolve, entry.IDs)
+				}
+				return
+			}
+			if !ok || !maps.Equal(entry.IDs, tt.want) {
+				t.Errorf("Resolve(%s) = %v, %v, want %v", tt.resolve, entry.IDs, ok, tt.want)
+			}
+			// Все ID записи указывают на неё же.
+			for ns, value := range entry.IDs {
+				if other, _ := m.Resolve(ID{ns, value}, entry.MediaType); !maps.Equal(other.IDs, entry.IDs) {
+					t.Errorf("Resolve(%s:%s) = %v, want %v", ns, value, other.IDs, entry.IDs)
+				}
+			}
+		})
+	}
+}
+
+func TestResolve(t *testing.T) {
+	m := New()
+	m.Add(movie, map[string]string{TMDb: "129", AniList: "199"})
+	m.Add(tv, map[string]string{TMDb: "129", AniList: "5"})
+	m.Add(tv, map[string]string{TMDb: "37854", AniList: "21"})
+
+	tests := []struct {
+		id            ID
+		mediaType     pb.MediaType
+		wantAniList   string
+		wantMediaType pb.MediaType
+	}{
+		{ID{TMDb, "129"}, movie, "199", movie},
+		{ID{TMDb, "129"}, tv, "5", tv},
+		// Без типа сначала ищется фильм.
+		{ID{TMDb, "129"}, pb.MediaType_MEDIA_TYPE_UNSPECIFIED, "199", movie},
+		{ID{TMDb, "37854"}, pb.MediaType_MEDIA_TYPE_UNSPECIFIED, "21", tv},
+		{ID{TMDb, "37854"}, movie, "", 0},
+		// ID остальных источников сквозные.
+		{ID{AniList, "21"}, movie, "21", tv},
+		{ID{AniList, "404"}, tv, "", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id.String()+"/"+tt.mediaType.String(), func(t *testing.T) {
+			entry, ok := m.Resolve(tt.id, tt.mediaType)
+			if ok != (tt.wantAniList != "") || entry.IDs[AniList] != tt.wantAniList || entry.MediaType != tt.wantMediaType {
+				t.Errorf("Resolve = %v %v, %v; want anilist:%s %v", entry.MediaType, entry.IDs, ok, tt.wantAniList, tt.wantMediaType)
+			}
+		})
+	}
+
+	entry, _ := m.Resolve(ID{AniList, "21"}, tv)
+	entry.IDs[MAL] = "изменено"
+	if again, _ := m.Resolve(ID{AniList, "21"}, tv); again.IDs[MAL] != "" {
+		t.Error("Resolve вернул запись, изменения которой попадают в набор")
+	}
+}
+
+func TestLearn(t *testing.T) {
+	m := New()
+	m.Add(tv, map[string]string{TMDb: "37854", AniList: "21", MAL: "21"})
+
+	details := &pb.TitleDetails{MediaType: tv, ExternalIds: map[string]string{AniList: "21", Kitsu: "12"}}
+	m.Learn(details)
+
+	want := map[string]string{TMDb: "37854", AniList: "21", MAL: "21", Shikimori: "21", Kitsu: "12"}
+	if !maps.Equal(details.GetExternalIds(), want) {
+		t.Errorf("ExternalIds = %v, want %v", details.GetExternalIds(), want)
+	}
+	if entry, ok := m.Resolve(ID{Kitsu, "12"}, tv); !ok || entry.IDs[TMDb] != "37854" {
+		t.Errorf("новый ID не запомнен: %v, %v", entry.IDs, ok)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	m := New()
+	n, err := m.Load(strings.NewReader(`[
+		{"type": "TV", "themoviedb_id": 37854, "anilist_id": 21, "mal_id": "21"},
+		{"type": "MOVIE", "themoviedb_id": 129, "anilist_id": 199, "imdb_id": "tt0245429"},
+		{"type": "OVA", "anilist_id": 1, "thetvdb_id": null}
+	]`))
+	if err != nil || n != 3 {
+		t.Fatalf("Load = %d, %v", n, err)
+	}
+	if m.Len() != 2 {
+		t.Errorf("Len = %d, want 2: запись с одним ID не нужна", m.Len())
+	}
+	if entry, ok := m.Resolve(ID{IMDb, "tt0245429"}, movie); !ok || entry.IDs[TMDb] != "129" {
+		t.Errorf("Resolve(imdb) = %v, %v", entry.IDs, ok)
+	}
+}
@@ -0,0 +1,97 @@
+package metadata
+
+import (
+	"context"
+	"maps"
+
+	"github.com/waste3d/Hikari-Anime/metadata/idmap"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WithIDMapping задаёт набор соответствий ID, например загруженный из
+// офлайн-файла. По умолчанию набор пустой и пополняется из ответов
+// источников.
+func WithIDMapping(m *idmap.Mapping) Option {
+	return func(s *Server) {
+		s.ids = m
+	}
+}
+
+func (s *Server) ResolveIDs(ctx context.Context, req *pb.ResolveIDsRequest) (*pb.ResolveIDsResponse, error) {
+	id, err := idmap.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	entry, ok := s.ids.Resolve(id, req.GetMediaType())
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "соответствия для ID %s не найдены", id)
+	}
+	return &pb.ResolveIDsResponse{
+		MediaType: entry.MediaType,
+		Ids:       entry.IDs,
+	}, nil
+}
+
+// resolveID переводит ID с пространством имён в ID источника, выбранного
+// для запроса. Если соответствие неизвестно, а пространство имён
+// совпадает с именем другого подключённого источника, запрос уходит к
+// нему.
+func (s *Server) resolveID(ctx context.Context, raw string, mediaType pb.MediaType) (provider.Provider, int64, error) {
+	id, err := idmap.Parse(raw)
+	if err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	p := s.providerFor(ctx)
+	target := id
+	if id.Namespace != p.Name() {
+		if entry, ok := s.ids.Resolve(id, mediaType); ok && entry.IDs[p.Name()] != "" {
+			target = idmap.ID{Namespace: p.Name(), Value: entry.IDs[p.Name()]}
+		} else if other, ok := s.providers[id.Namespace]; ok {
+			p = other
+			provider.ReportOrigin(ctx, p.Name())
+		} else {
+			return nil, 0, status.Errorf(codes.NotFound, "ID %s не сопоставлен с источником %s", id, p.Name())
+		}
+	}
+
+	value, err := target.Int()
+	if err != nil {
+		return nil, 0, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return p, value, nil
+}
+
+// titleProvider выбирает источник и ID тайтла для запросов, в которых
+// тайтл задан ID источника (titleID) или ID с пространством имён
+// (namespaced), а тип — фильм или сериал.
+func (s *Server) titleProvider(ctx context.Context, mediaType pb.MediaType, titleID int64, namespaced string) (provider.Provider, int64, error) {
+	if mediaType != pb.MediaType_MEDIA_TYPE_MOVIE && mediaType != pb.MediaType_MEDIA_TYPE_TV {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "media_type должен быть фильмом или сериалом")
+	}
+
+	p, id := s.providerFor(ctx), titleID
+	if namespaced != "" {
+		var err error
+		if p, id, err = s.resolveID(ctx, namespaced, mediaType); err != nil {
+			return nil, 0, err
+		}
+	}
+	if id == 0 {
+		return nil, 0, status.Errorf(codes.InvalidArgument, "ID тайтла (title_id) не может быть равен 0")
+	}
+	return p, id, nil
+}
+
+// withExternalIDs запоминает внешние ID из details и дополняет их
+// известными соответствиями. details может быть закэширован, поэтому
+// изменяется копия карты.
+func (s *Server) withExternalIDs(details *pb.TitleDetails) *pb.TitleDetails {
+	details.ExternalIds = maps.Clone(details.GetExternalIds())
+	s.ids.Learn(details)
+	return details
+}
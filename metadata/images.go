@@ -0,0 +1,56 @@
+package metadata
+
+import (
+	"context"
+
+	"github.com/waste3d/Hikari-Anime/metadata/images"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// ImageSizesMetadataKey — ключ gRPC-метаданных, которым клиент выбирает
+// размеры изображений для отдельного запроса: список через запятую,
+// например "w185,w500,original".
+const ImageSizesMetadataKey = "x-hikari-image-sizes"
+
+// WithImages задаёт построитель адресов изображений. Без него адреса
+// строятся по images.DefaultConfig в размерах по умолчанию.
+func WithImages(b *images.Builder) Option {
+	return func(s *Server) {
+		s.images = b
+	}
+}
+
+// ImageInterceptor возвращает серверный перехватчик, который заполняет
+// адреса изображений в ответах в размерах из ImageSizesMetadataKey. Он
+// должен стоять в цепочке раньше CacheInterceptor и CatalogInterceptor:
+// там хранятся ответы только с путями, а адреса строятся при каждом
+// вызове.
+func (s *Server) ImageInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if info.Server != s {
+			return handler(ctx, req)
+		}
+
+		var sizes []string
+		md, _ := grpcmetadata.FromIncomingContext(ctx)
+		if values := md.Get(ImageSizesMetadataKey); len(values) > 0 {
+			var err error
+			if sizes, err = images.ParseSizes(values[0]); err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if msg, ok := resp.(proto.Message); ok {
+			s.images.Fill(ctx, msg, sizes)
+		}
+		return resp, nil
+	}
+}
@@ -0,0 +1,101 @@
+package images
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// testImage возвращает изображение w×h, цвет пикселей которого задаёт at.
+func testImage(w, h int, at func(x, y int) color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		for x := range w {
+			img.SetRGBA(x, y, at(x, y))
+		}
+	}
+	return img
+}
+
+// inCircle сообщает, лежит ли точка внутри круга радиуса 10 с центром
+// (16, 18).
+func inCircle(x, y int) bool {
+	return (x-16)*(x-16)+(y-18)*(y-18) < 100
+}
+
+func TestBlurhash(t *testing.T) {
+	// Эталонные строки посчитаны C-кодировщиком из woltapp/blurhash
+	// (blurHashForPixels с 3×4 компонентами) для тех же пикселей.
+	tests := []struct {
+		name string
+		img  *image.RGBA
+		want string
+	}{
+		{
+			name: "градиент",
+			img: testImage(32, 48, func(x, y int) color.RGBA {
+				return color.RGBA{uint8(x * 255 / 31), uint8(y * 255 / 47), 128, 255}
+			}),
+			want: "T$Het82swxl|agjtgcfjfQnSa|jt",
+		},
+		{
+			name: "круг на тёмном фоне",
+			img: testImage(32, 48, func(x, y int) color.RGBA {
+				if inCircle(x, y) {
+					return color.RGBA{200, 30, 40, 255}
+				}
+				return color.RGBA{20, 24, 60, 255}
+			}),
+			want: "TMBAx5j[1|;}fQJm1|fQ;}1|fQ,q",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := blurhash(tt.img); got != tt.want {
+				t.Errorf("blurhash = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColors(t *testing.T) {
+	solid := func(c color.RGBA) func(x, y int) color.RGBA {
+		return func(x, y int) color.RGBA { return c }
+	}
+	circle := func(fg, bg color.RGBA) func(x, y int) color.RGBA {
+		return func(x, y int) color.RGBA {
+			if inCircle(x, y) {
+				return fg
+			}
+			return bg
+		}
+	}
+	var (
+		red         = color.RGBA{200, 30, 40, 255}
+		gray        = color.RGBA{128, 128, 128, 255}
+		navy        = color.RGBA{20, 24, 60, 255}
+		transparent = color.RGBA{}
+	)
+
+	tests := []struct {
+		name                     string
+		at                       func(x, y int) color.RGBA
+		wantDominant, wantAccent string
+	}{
+		{name: "один цвет", at: solid(red), wantDominant: "#c81e28", wantAccent: "#c81e28"},
+		{name: "акцент — насыщенный цвет на сером фоне", at: circle(red, gray), wantDominant: "#808080", wantAccent: "#c81e28"},
+		{name: "без насыщенных цветов акцент совпадает с фоном", at: circle(color.RGBA{250, 250, 250, 255}, gray), wantDominant: "#808080", wantAccent: "#808080"},
+		{name: "насыщенный фон частого цвета важнее яркого пятна", at: circle(red, navy), wantDominant: "#14183c", wantAccent: "#14183c"},
+		{name: "слишком тёмный цвет не акцент", at: circle(color.RGBA{0, 0, 30, 255}, gray), wantDominant: "#808080", wantAccent: "#808080"},
+		{name: "прозрачные пиксели не считаются", at: circle(red, transparent), wantDominant: "#c81e28", wantAccent: "#c81e28"},
+		{name: "полностью прозрачное", at: solid(transparent)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dominant, accent := colors(testImage(32, 48, tt.at))
+			if dominant != tt.wantDominant || accent != tt.wantAccent {
+				t.Errorf("colors = %q, %q, want %q, %q", dominant, accent, tt.wantDominant, tt.wantAccent)
+			}
+		})
+	}
+}
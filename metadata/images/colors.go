@@ -0,0 +1,83 @@
+package images
+
+import (
+	"fmt"
+	"image"
+)
+
+// colorBucket накапливает пиксели одного оттенка: каналы огрублены до
+// 3 бит, чтобы близкие цвета считались одним.
+type colorBucket struct {
+	count   int
+	r, g, b int
+}
+
+func (c colorBucket) average() (r, g, b int) {
+	return c.r / c.count, c.g / c.count, c.b / c.count
+}
+
+// colors возвращает преобладающий и акцентный цвета img в виде
+// "#rrggbb". Акцентный — самый частый среди насыщенных цветов средней
+// яркости; если таких нет, он совпадает с преобладающим.
+func colors(img *image.RGBA) (dominant, accent string) {
+	b := img.Bounds()
+	var buckets [512]colorBucket
+	for y := range b.Dy() {
+		row := img.Pix[y*img.Stride:]
+		for x := range b.Dx() {
+			px := row[x*4:]
+			if px[3] < 128 {
+				continue
+			}
+			key := int(px[0]>>5)<<6 | int(px[1]>>5)<<3 | int(px[2]>>5)
+			bucket := &buckets[key]
+			bucket.count++
+			bucket.r += int(px[0])
+			bucket.g += int(px[1])
+			bucket.b += int(px[2])
+		}
+	}
+	var top, vivid *colorBucket
+	var vividScore float64
+	for i := range buckets {
+		bucket := &buckets[i]
+		if bucket.count == 0 {
+			continue
+		}
+		if top == nil || bucket.count > top.count {
+			top = bucket
+		}
+		saturation, lightness := hsl(bucket.average())
+		if saturation < 0.3 || lightness < 0.15 || lightness > 0.85 {
+			continue
+		}
+		if score := float64(bucket.count) * saturation; vivid == nil || score > vividScore {
+			vivid, vividScore = bucket, score
+		}
+	}
+	if top == nil {
+		return "", ""
+	}
+	if vivid == nil {
+		vivid = top
+	}
+	return hexColor(top.average()), hexColor(vivid.average())
+}
+
+// hsl возвращает насыщенность и светлость цвета в модели HSL, от 0 до 1.
+func hsl(r, g, b int) (saturation, lightness float64) {
+	hi := float64(max(r, g, b)) / 255
+	lo := float64(min(r, g, b)) / 255
+	lightness = (hi + lo) / 2
+	if hi == lo {
+		return 0, lightness
+	}
+	if lightness > 0.5 {
+		return (hi - lo) / (2 - hi - lo), lightness
+	}
+	return (hi - lo) / (hi + lo), lightness
+}
+
+func hexColor(r, g, b int) string {
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
@@ -0,0 +1,328 @@
+// Package images строит адреса изображений в нескольких размерах по
+// путям, которые возвращают источники метаданных.
+//
+// Адреса TMDb собираются из базового адреса и списка размеров из ответа
+// /configuration: он запрашивается один раз при первом обращении, а до
+// успешного ответа используется DefaultConfig. Изображения остальных
+// источников приходят полными адресами и отдаются как есть в размере
+// "original".
+package images
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Original — размер исходного изображения.
+const Original = "original"
+
+// Config — базовый адрес и доступные размеры изображений по видам.
+type Config struct {
+	BaseURL string
+	Sizes   map[pb.ImageKind][]string
+}
+
+// DefaultConfig совпадает с ответом /configuration TMDb на момент
+// написания и используется, пока настоящий ответ не получен.
+var DefaultConfig = Config{
+	BaseURL: "https://image.tmdb.org/t/p/",
+	Sizes: map[pb.ImageKind][]string{
+		pb.ImageKind_IMAGE_KIND_POSTER:   {"w92", "w154", "w185", "w342", "w500", "w780", Original},
+		pb.ImageKind_IMAGE_KIND_BACKDROP: {"w300", "w780", "w1280", Original},
+		pb.ImageKind_IMAGE_KIND_PROFILE:  {"w45", "w185", "h632", Original},
+		pb.ImageKind_IMAGE_KIND_STILL:    {"w92", "w185", "w300", Original},
+	},
+}
+
+// Source загружает конфигурацию изображений, например из TMDb.
+type Source func(ctx context.Context) (Config, error)
+
+const (
+	// retryAfter — пауза перед повторной загрузкой конфигурации после ошибки.
+	retryAfter = time.Minute
+	// loadTimeout ограничивает загрузку конфигурации. Она не зависит от
+	// отмены запроса, который её начал: результат нужен всем следующим.
+	loadTimeout = 10 * time.Second
+	// legacySize — размер, в котором строятся устаревшие поля с адресами
+	// изображений, например poster_path.
+	legacySize = "w500"
+)
+
+// Builder заполняет адреса изображений в ответах.
+type Builder struct {
+	source       Source
+	defaultSizes []string
+	baseURL      string
+	placeholders *Placeholders
+	loading      singleflight.Group
+
+	mu      sync.Mutex
+	config  *Config
+	retryAt time.Time
+}
+
+// New создаёт Builder, который берёт конфигурацию из source (nil — всегда
+// DefaultConfig) и по умолчанию строит адреса в размерах defaultSizes.
+// Непустой baseURL заменяет базовый адрес из конфигурации, например на
+// адрес прокси изображений.
+func New(source Source, defaultSizes []string, baseURL string) *Builder {
+	if baseURL != "" && !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+	return &Builder{source: source, defaultSizes: defaultSizes, baseURL: baseURL}
+}
+
+// WithPlaceholders включает заглушки для постеров: BlurHash и цвета из p
+// добавляются ко всем постерам, которые заполняет Fill.
+func (b *Builder) WithPlaceholders(p *Placeholders) *Builder {
+	b.placeholders = p
+	return b
+}
+
+// Config возвращает конфигурацию изображений, при необходимости загружая
+// её из источника.
+func (b *Builder) Config(ctx context.Context) Config {
+	config := b.load(ctx)
+	if b.baseURL != "" {
+		config.BaseURL = b.baseURL
+	}
+	return config
+}
+
+// load возвращает загруженную конфигурацию или DefaultConfig, пока её
+// нет. Одновременные вызовы ждут одну загрузку, но не дольше, чем
+// позволяет их ctx.
+func (b *Builder) load(ctx context.Context) Config {
+	if config, ok := b.cached(); ok || b.source == nil {
+		return config
+	}
+
+	ch := b.loading.DoChan("", func() (any, error) {
+		if config, ok := b.cached(); ok {
+			return config, nil
+		}
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loadTimeout)
+		defer cancel()
+		config, err := b.source(loadCtx)
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if err != nil {
+			log.Printf("не удалось загрузить конфигурацию изображений, использую стандартную: %v", err)
+			b.retryAt = time.Now().Add(retryAfter)
+			return DefaultConfig, nil
+		}
+		b.config = &config
+		return config, nil
+	})
+
+	select {
+	case res := <-ch:
+		return res.Val.(Config)
+	case <-ctx.Done():
+		return DefaultConfig
+	}
+}
+
+// cached возвращает загруженную конфигурацию. ok = false означает, что
+// её нужно загрузить; до следующей попытки после ошибки возвращается
+// DefaultConfig с ok = true.
+func (b *Builder) cached() (config Config, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch {
+	case b.config != nil:
+		return *b.config, true
+	case time.Now().Before(b.retryAt):
+		return DefaultConfig, true
+	default:
+		return DefaultConfig, false
+	}
+}
+
+// size — размер изображения: "original", ширина "w342" или высота "h632".
+var size = regexp.MustCompile(`^(original|[wh][1-9][0-9]*)$`)
+
+// ParseSizes разбирает список размеров через запятую. Пустая строка
+// означает размеры по умолчанию.
+func ParseSizes(value string) ([]string, error) {
+	var sizes []string
+	for _, s := range strings.Split(value, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if !size.MatchString(s) {
+			return nil, fmt.Errorf("некорректный размер изображения %q: ожидается \"original\", \"w342\" или \"h632\"", s)
+		}
+		sizes = append(sizes, s)
+	}
+	return sizes, nil
+}
+
+// Fill заполняет адреса всех изображений в msg, включая вложенные
+// сообщения, в размерах sizes (nil — размеры по умолчанию), и
+// устаревшие поля с их адресами в размере legacySize.
+func (b *Builder) Fill(ctx context.Context, msg proto.Message, sizes []string) {
+	if len(sizes) == 0 {
+		sizes = b.defaultSizes
+	}
+	upstream := b.load(ctx)
+	config := upstream
+	if b.baseURL != "" {
+		config.BaseURL = b.baseURL
+	}
+	walk(msg.ProtoReflect(), func(m proto.Message) {
+		switch m := m.(type) {
+		case *pb.Image:
+			config.fill(m, sizes)
+			if b.placeholders != nil && m.GetKind() == pb.ImageKind_IMAGE_KIND_POSTER {
+				b.placeholders.apply(m, upstream.url(m, "w"+strconv.Itoa(thumbnailSide)))
+			}
+		case *pb.Movie:
+			config.legacy(&m.PosterPath, m.Poster)
+		case *pb.TitleDetails:
+			config.legacy(&m.PosterPath, m.Poster)
+			config.legacy(&m.BackdropPath, m.Backdrop)
+		case *pb.Season:
+			config.legacy(&m.PosterPath, m.Poster)
+		case *pb.Episode:
+			config.legacy(&m.StillPath, m.Still)
+		case *pb.PersonSummary:
+			config.legacy(&m.ProfilePath, m.Profile)
+		case *pb.PersonDetails:
+			config.legacy(&m.ProfilePath, m.Profile)
+		case *pb.Character:
+			config.legacy(&m.ImagePath, m.Image)
+		}
+	})
+}
+
+// walk вызывает visit для m и каждого вложенного в него сообщения.
+func walk(m protoreflect.Message, visit func(proto.Message)) {
+	visit(m.Interface())
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := range list.Len() {
+				walk(list.Get(i).Message(), visit)
+			}
+		default:
+			walk(v.Message(), visit)
+		}
+		return true
+	})
+}
+
+func (c Config) fill(image *pb.Image, sizes []string) {
+	path := image.GetPath()
+	if isURL(path) {
+		image.Urls = map[string]string{Original: path}
+		image.Srcset = ""
+		return
+	}
+
+	image.Urls = make(map[string]string, len(sizes))
+	var srcset []string
+	for _, s := range c.resolve(image.GetKind(), sizes) {
+		url := c.BaseURL + s + path
+		image.Urls[s] = url
+		if width, ok := strings.CutPrefix(s, "w"); ok {
+			srcset = append(srcset, url+" "+width+"w")
+		}
+	}
+	image.Srcset = strings.Join(srcset, ", ")
+}
+
+// legacy записывает в устаревшее поле field адрес image в размере
+// legacySize. Без изображения поле не меняется.
+func (c Config) legacy(field *string, image *pb.Image) {
+	switch path := image.GetPath(); {
+	case path == "":
+	case isURL(path):
+		*field = path
+	default:
+		*field = c.BaseURL + legacySize + path
+	}
+}
+
+// url возвращает адрес копии изображения в размере size или ближайшем
+// большем из доступных.
+func (c Config) url(image *pb.Image, size string) string {
+	path := image.GetPath()
+	if isURL(path) {
+		return path
+	}
+	return c.BaseURL + c.resolve(image.GetKind(), []string{size})[0] + path
+}
+
+// resolve заменяет каждый запрошенный размер ближайшим доступным для
+// вида kind: тем же, наименьшим не меньше запрошенного по той же стороне
+// или исходным. Результат упорядочен по возрастанию, исходный размер
+// последний.
+func (c Config) resolve(kind pb.ImageKind, sizes []string) []string {
+	available := c.Sizes[kind]
+	if len(available) == 0 {
+		available = DefaultConfig.Sizes[kind]
+	}
+
+	var resolved []string
+	for _, want := range sizes {
+		got := Original
+		if slices.Contains(available, want) {
+			got = want
+		} else if want != Original {
+			side, n := dimension(want)
+			best := 0
+			for _, s := range available {
+				if sSide, sN := dimension(s); sSide == side && sN >= n && (best == 0 || sN < best) {
+					got, best = s, sN
+				}
+			}
+		}
+		if !slices.Contains(resolved, got) {
+			resolved = append(resolved, got)
+		}
+	}
+	slices.SortFunc(resolved, func(a, b string) int {
+		_, an := dimension(a)
+		_, bn := dimension(b)
+		return cmp.Compare(an, bn)
+	})
+	return resolved
+}
+
+// dimension разбирает размер "w342" на сторону и число пикселей. Для
+// исходного размера возвращается наибольшее число, чтобы он шёл последним.
+func dimension(s string) (byte, int) {
+	if s == Original || s == "" {
+		return 0, math.MaxInt
+	}
+	n, err := strconv.Atoi(s[1:])
+	if err != nil {
+		return 0, math.MaxInt
+	}
+	return s[0], n
+}
+
+// isURL сообщает, задан ли путь изображения полным адресом.
+func isURL(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
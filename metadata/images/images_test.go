@@ -0,0 +1,156 @@
+package images
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+var testConfig = Config{
+	BaseURL: "https://upstream.example/",
+	Sizes:   map[pb.ImageKind][]string{pb.ImageKind_IMAGE_KIND_POSTER: {"w500", Original}},
+}
+
+func TestBuilderLoad(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan context.Context, 1)
+	release := make(chan struct{})
+	b := New(func(ctx context.Context) (Config, error) {
+		calls.Add(1)
+		started <- ctx
+		<-release
+		return testConfig, nil
+	}, nil, "")
+
+	// Запрос, начавший загрузку, отменяется, не дождавшись её.
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	first := make(chan Config, 1)
+	go func() { first <- b.Config(firstCtx) }()
+	loadCtx := <-started
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := b.Config(context.Background()); got.BaseURL != testConfig.BaseURL {
+				t.Errorf("BaseURL = %q, want %q", got.BaseURL, testConfig.BaseURL)
+			}
+		}()
+	}
+
+	// Пока идёт загрузка, вызовы с истёкшим контекстом не ждут её.
+	expired, cancel := context.WithCancel(context.Background())
+	cancel()
+	done := make(chan Config, 1)
+	go func() { done <- b.Config(expired) }()
+	select {
+	case got := <-done:
+		if got.BaseURL != DefaultConfig.BaseURL {
+			t.Errorf("BaseURL = %q, want стандартный", got.BaseURL)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("вызов ждёт загрузку, начатую другим запросом")
+	}
+
+	cancelFirst()
+	if got := <-first; got.BaseURL != DefaultConfig.BaseURL {
+		t.Errorf("отменённый вызов: BaseURL = %q, want стандартный", got.BaseURL)
+	}
+	if err := loadCtx.Err(); err != nil {
+		t.Errorf("загрузка прервана отменой запроса: %v", err)
+	}
+	if deadline, ok := loadCtx.Deadline(); !ok || time.Until(deadline) > loadTimeout {
+		t.Errorf("дедлайн загрузки = %v, %v, want не позже чем через %s", deadline, ok, loadTimeout)
+	}
+
+	close(release)
+	wg.Wait()
+	if got := b.Config(context.Background()); got.BaseURL != testConfig.BaseURL {
+		t.Errorf("после загрузки BaseURL = %q, want %q", got.BaseURL, testConfig.BaseURL)
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("загрузок = %d, want 1", got)
+	}
+}
+
+func TestBuilderLoadError(t *testing.T) {
+	var calls atomic.Int32
+	b := New(func(ctx context.Context) (Config, error) {
+		calls.Add(1)
+		return Config{}, errors.New("API недоступен")
+	}, nil, "https://proxy.example")
+
+	for range 3 {
+		got := b.Config(context.Background())
+		if got.BaseURL != "https://proxy.example/" || len(got.Sizes) == 0 {
+			t.Errorf("Config = %+v, want стандартная с адресом прокси", got)
+		}
+	}
+	if got := calls.Load(); got != 1 {
+		t.Errorf("загрузок до retryAfter = %d, want 1", got)
+	}
+}
+
+func TestFillLegacyPaths(t *testing.T) {
+	b := New(func(ctx context.Context) (Config, error) {
+		return testConfig, nil
+	}, []string{Original}, "https://proxy.example/")
+
+	poster := func(path string) *pb.Image {
+		return &pb.Image{Kind: pb.ImageKind_IMAGE_KIND_POSTER, Path: path}
+	}
+	msg := &pb.SearchResponse{Results: []*pb.Movie{
+		{Id: 1, Poster: poster("/tmdb.jpg")},
+		{Id: 2, Poster: poster("https://s4.anilist.co/cover.jpg")},
+		{Id: 3, PosterPath: "/старый.jpg"},
+	}}
+	b.Fill(context.Background(), msg, nil)
+
+	want := []string{
+		"https://proxy.example/w500/tmdb.jpg",
+		"https://s4.anilist.co/cover.jpg",
+		"/старый.jpg",
+	}
+	for i, m := range msg.GetResults() {
+		if m.GetPosterPath() != want[i] {
+			t.Errorf("PosterPath %d = %q, want %q", m.GetId(), m.GetPosterPath(), want[i])
+		}
+	}
+	if got := msg.GetResults()[0].GetPoster().GetUrls()[Original]; got != "https://proxy.example/original/tmdb.jpg" {
+		t.Errorf("адрес постера = %q", got)
+	}
+
+	details := &pb.TitleDetails{
+		Poster:   poster("/p.jpg"),
+		Backdrop: &pb.Image{Kind: pb.ImageKind_IMAGE_KIND_BACKDROP, Path: "/b.jpg"},
+	}
+	characters := &pb.CharactersResponse{Results: []*pb.Character{{
+		Image: &pb.Image{Kind: pb.ImageKind_IMAGE_KIND_PROFILE, Path: "/c.jpg"},
+		VoiceActors: []*pb.VoiceActor{{Person: &pb.PersonSummary{
+			Profile: &pb.Image{Kind: pb.ImageKind_IMAGE_KIND_PROFILE, Path: "/va.jpg"},
+		}}},
+	}}}
+	b.Fill(context.Background(), details, nil)
+	b.Fill(context.Background(), characters, nil)
+
+	character := characters.GetResults()[0]
+	tests := []struct {
+		field, got, want string
+	}{
+		{"TitleDetails.PosterPath", details.GetPosterPath(), "https://proxy.example/w500/p.jpg"},
+		{"TitleDetails.BackdropPath", details.GetBackdropPath(), "https://proxy.example/w500/b.jpg"},
+		{"Character.ImagePath", character.GetImagePath(), "https://proxy.example/w500/c.jpg"},
+		{"PersonSummary.ProfilePath", character.GetVoiceActors()[0].GetPerson().GetProfilePath(), "https://proxy.example/w500/va.jpg"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.field, tt.got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package images
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg" // постеры TMDb и AniList
+	_ "image/png"
+	"log"
+	"sync"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// Fetch загружает изображение по адресу url.
+type Fetch func(ctx context.Context, url string) ([]byte, error)
+
+const (
+	// thumbnailSide — наибольшая сторона уменьшенной копии, по которой
+	// считаются BlurHash и цвета: детали крупнее всё равно не видны.
+	thumbnailSide = 64
+	// fetchTimeout ограничивает загрузку одного изображения.
+	fetchTimeout = 15 * time.Second
+	// queueSize — сколько изображений может ждать обработки; остальные
+	// откладываются до следующего ответа с ними.
+	queueSize = 1024
+	// retryFailedAfter — через сколько изображение, которое не удалось
+	// обработать, ставится в очередь снова.
+	retryFailedAfter = 5 * time.Minute
+)
+
+// placeholder — результат обработки одного изображения. Непустой
+// retryAt означает, что изображение обработать не удалось и после
+// retryAt его нужно загрузить снова.
+type placeholder struct {
+	key                   string
+	blurhash              string
+	dominantColor, accent string
+	retryAt               time.Time
+}
+
+type placeholderJob struct {
+	key, url string
+}
+
+// Placeholders вычисляет в фоне BlurHash и цвета изображений и хранит
+// последние maxEntries результатов по пути изображения.
+type Placeholders struct {
+	fetch      Fetch
+	maxEntries int
+	queue      chan placeholderJob
+
+	mu      sync.Mutex
+	lru     *list.List // *placeholder, недавние спереди
+	done    map[string]*list.Element
+	pending map[string]bool
+}
+
+// NewPlaceholders запускает workers фоновых обработчиков, которые
+// загружают изображения через fetch.
+func NewPlaceholders(fetch Fetch, workers, maxEntries int) *Placeholders {
+	p := &Placeholders{
+		fetch:      fetch,
+		maxEntries: maxEntries,
+		queue:      make(chan placeholderJob, queueSize),
+		lru:        list.New(),
+		done:       make(map[string]*list.Element),
+		pending:    make(map[string]bool),
+	}
+	for range workers {
+		go p.work()
+	}
+	return p
+}
+
+// apply заполняет заглушку изображения image, если она уже вычислена, и
+// иначе ставит изображение в очередь: загружено оно будет по адресу url.
+func (p *Placeholders) apply(image *pb.Image, url string) {
+	key := image.GetPath()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if el, ok := p.done[key]; ok {
+		p.lru.MoveToFront(el)
+		result := el.Value.(*placeholder)
+		if result.retryAt.IsZero() {
+			image.Blurhash = result.blurhash
+			image.DominantColor = result.dominantColor
+			image.AccentColor = result.accent
+			return
+		}
+		if time.Now().Before(result.retryAt) {
+			return
+		}
+	}
+	if p.pending[key] {
+		return
+	}
+	select {
+	case p.queue <- placeholderJob{key: key, url: url}:
+		p.pending[key] = true
+	default:
+	}
+}
+
+func (p *Placeholders) work() {
+	for job := range p.queue {
+		result, err := p.process(job)
+		if err != nil {
+			log.Printf("не удалось построить заглушку для %s: %v", job.url, err)
+			result.retryAt = time.Now().Add(retryFailedAfter)
+		}
+
+		p.mu.Lock()
+		delete(p.pending, job.key)
+		if el, ok := p.done[job.key]; ok {
+			p.lru.Remove(el)
+		}
+		p.done[job.key] = p.lru.PushFront(result)
+		for p.lru.Len() > p.maxEntries {
+			oldest := p.lru.Back()
+			p.lru.Remove(oldest)
+			delete(p.done, oldest.Value.(*placeholder).key)
+		}
+		p.mu.Unlock()
+	}
+}
+
+// process загружает изображение и вычисляет его заглушку. При ошибке
+// возвращается пустая заглушка.
+func (p *Placeholders) process(job placeholderJob) (*placeholder, error) {
+	result := &placeholder{key: job.key}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+	data, err := p.fetch(ctx, job.url)
+	if err != nil {
+		return result, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return result, fmt.Errorf("ошибка декодирования: %w", err)
+	}
+
+	thumb := thumbnail(img, thumbnailSide)
+	result.blurhash = blurhash(thumb)
+	result.dominantColor, result.accent = colors(thumb)
+	return result, nil
+}
+
+// thumbnail уменьшает img так, чтобы большая сторона не превышала side.
+// Точки берутся из ближайших пикселей: для размытой заглушки этого
+// достаточно.
+func thumbnail(img image.Image, side int) *image.RGBA {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w > side || h > side {
+		if w >= h {
+			w, h = side, max(1, h*side/w)
+		} else {
+			w, h = max(1, w*side/h), side
+		}
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := range h {
+		sy := b.Min.Y + y*b.Dy()/h
+		for x := range w {
+			sx := b.Min.X + x*b.Dx()/w
+			thumb.Set(x, y, color.RGBAModel.Convert(img.At(sx, sy)))
+		}
+	}
+	return thumb
+}
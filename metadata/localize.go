@@ -0,0 +1,120 @@
+package metadata
+
+import (
+	"context"
+	"log"
+	"slices"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// OriginalLanguage — элемент цепочки запасных языков, который означает
+// язык оригинала тайтла.
+const OriginalLanguage = "original"
+
+// WithLanguageFallback задаёт языки, из которых по порядку берутся поля
+// TitleDetails, не переведённые на язык запроса. По умолчанию поля не
+// дополняются.
+func WithLanguageFallback(languages []string) Option {
+	return func(s *Server) {
+		s.fallbackLanguages = languages
+	}
+}
+
+// localizedField — поле TitleDetails, которое источник переводит.
+type localizedField struct {
+	name string
+	get  func(*pb.TitleDetails) string
+	set  func(*pb.TitleDetails, string)
+	// untranslated сообщает, что значение в d не переведено на язык
+	// language.
+	untranslated func(d *pb.TitleDetails, language string) bool
+	// optional — поле заполняется только попутно, из тайтла, полученного
+	// ради других полей: слоганов нет у AniList и у большинства аниме в
+	// TMDb, и запросы ради них почти всегда были бы напрасными.
+	optional bool
+}
+
+var localizedFields = []localizedField{
+	{
+		name: "title",
+		get:  (*pb.TitleDetails).GetTitle,
+		set:  func(d *pb.TitleDetails, v string) { d.Title = v },
+		// Без перевода источник отдаёт название на языке оригинала.
+		untranslated: func(d *pb.TitleDetails, language string) bool {
+			return d.GetTitle() == "" || (d.GetTitle() == d.GetOriginalTitle() &&
+				baseLanguage(d.GetOriginalLanguage()) != baseLanguage(language))
+		},
+	},
+	{
+		name:         "overview",
+		get:          (*pb.TitleDetails).GetOverview,
+		set:          func(d *pb.TitleDetails, v string) { d.Overview = v },
+		untranslated: func(d *pb.TitleDetails, _ string) bool { return d.GetOverview() == "" },
+	},
+	{
+		name:         "tagline",
+		get:          (*pb.TitleDetails).GetTagline,
+		set:          func(d *pb.TitleDetails, v string) { d.Tagline = v },
+		untranslated: func(d *pb.TitleDetails, _ string) bool { return d.GetTagline() == "" },
+		optional:     true,
+	},
+}
+
+// localize дополняет поля details, не переведённые на язык language,
+// значениями на запасных языках. fetch получает тот же тайтл на другом
+// языке. Заполненные поля перечисляются в details.FallbackLanguages;
+// ошибки запасных запросов только логируются.
+func (s *Server) localize(ctx context.Context, details *pb.TitleDetails, language string,
+	fetch func(ctx context.Context, language string) (*pb.TitleDetails, error),
+) {
+	var missing []localizedField
+	for _, f := range localizedFields {
+		if f.untranslated(details, language) {
+			missing = append(missing, f)
+		}
+	}
+
+	required := func(f localizedField) bool { return !f.optional }
+
+	tried := []string{baseLanguage(language)}
+	for _, fallback := range s.fallbackLanguages {
+		if !slices.ContainsFunc(missing, required) {
+			break
+		}
+		original := fallback == OriginalLanguage
+		if original {
+			fallback = details.GetOriginalLanguage()
+		}
+		if fallback == "" || slices.Contains(tried, baseLanguage(fallback)) {
+			continue
+		}
+		tried = append(tried, baseLanguage(fallback))
+
+		alt := details
+		// Название на языке оригинала уже есть в original_title.
+		if !original || slices.ContainsFunc(missing, func(f localizedField) bool { return f.name != "title" && !f.optional }) {
+			var err error
+			if alt, err = fetch(ctx, fallback); err != nil {
+				log.Printf("не удалось получить тайтл %d на языке %s: %v", details.GetId(), fallback, err)
+				continue
+			}
+		}
+
+		missing = slices.DeleteFunc(missing, func(f localizedField) bool {
+			value := f.get(alt)
+			if original && f.name == "title" {
+				value = alt.GetOriginalTitle()
+			}
+			if value == "" || (!original && f.untranslated(alt, fallback)) {
+				return false
+			}
+			if details.FallbackLanguages == nil {
+				details.FallbackLanguages = map[string]string{}
+			}
+			f.set(details, value)
+			details.FallbackLanguages[f.name] = fallback
+			return true
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package metadata
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/searchindex"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// searchPageSize — число результатов на странице поиска у источников.
+	searchPageSize = 20
+	// localSearchLimit — сколько тайтлов из индекса рассматривается для
+	// одной страницы поиска.
+	localSearchLimit = 20
+	// strongLocalMatch — оценка, начиная с которой тайтл из индекса
+	// ставится перед результатами источника: источник не нашёл то, что
+	// пользователь почти наверняка ищет.
+	strongLocalMatch = 0.8
+)
+
+// WithSearchIndex включает локальный поисковый индекс: тайтлы из ответов
+// добавляются в него через SearchIndexInterceptor, а SearchMovies и
+// SearchTVShows дополняют им результаты источника.
+func WithSearchIndex(ix *searchindex.Index) Option {
+	return func(s *Server) {
+		s.index = ix
+	}
+}
+
+// SearchIndexInterceptor возвращает серверный перехватчик, который
+// добавляет в поисковый индекс все тайтлы из успешных ответов под именем
+// ответившего источника. Он должен стоять в цепочке последним: ответы
+// из кэша и каталога не говорят, какой источник их дал, и попадают в
+// индекс, когда приходят от источника. Ответы запасного источника не
+// индексируются.
+func (s *Server) SearchIndexInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || s.index == nil || info.Server != s || servedByFallback(ctx) {
+			return resp, err
+		}
+
+		var language string
+		if r, ok := req.(interface{ GetLanguage() string }); ok {
+			language = r.GetLanguage()
+		}
+		if msg, ok := resp.(proto.Message); ok {
+			s.indexTitles(s.answeredBy(ctx), language, msg.ProtoReflect())
+		}
+		return resp, nil
+	}
+}
+
+// IndexCatalog добавляет в поисковый индекс тайтлы из ответов,
+// сохранённых в каталоге, чтобы локальный поиск работал сразу после
+// перезапуска. Ответы на запросы с ID с пространством имён пропускаются:
+// на них мог ответить источник этого пространства, а какой — по ключу
+// не узнать.
+func (s *Server) IndexCatalog() error {
+	if s.index == nil || s.catalog == nil {
+		return nil
+	}
+
+	err := s.catalog.EachResponse(func(key cache.Key, msg proto.Message) {
+		name, request := s.splitKey(key)
+		if !hasNamespacedID(key.RPC, request) {
+			s.indexTitles(name, "", msg.ProtoReflect())
+		}
+	})
+	if err != nil {
+		return err
+	}
+	log.Printf("В поисковый индекс добавлено %d тайтлов из каталога", s.index.Len())
+	return nil
+}
+
+// splitKey возвращает имя источника, выбранного для запроса, ответ на
+// который сохранён под ключом key, и сам запрос (см. cacheKey).
+func (s *Server) splitKey(key cache.Key) (name, request string) {
+	if name, request, ok := strings.Cut(key.Request, "\x00"); ok {
+		if _, known := s.providers[name]; known {
+			return name, request
+		}
+	}
+	return s.provider.Name(), key.Request
+}
+
+// hasNamespacedID сообщает, задан ли в запросе request метода rpc тайтл
+// ID с пространством имён (строковое поле id). Запросы, которые не
+// удаётся разобрать, считаются такими.
+func hasNamespacedID(rpc, request string) bool {
+	method := pb.File_metadata_proto_metadata_proto.Services().ByName("MetadataService").Methods().ByName(protoreflect.Name(rpc))
+	if method == nil {
+		return true
+	}
+	msg := dynamicpb.NewMessage(method.Input())
+	if err := proto.Unmarshal([]byte(request), msg); err != nil {
+		return true
+	}
+	fd := method.Input().Fields().ByName("id")
+	return fd != nil && fd.Kind() == protoreflect.StringKind && msg.Get(fd).String() != ""
+}
+
+// indexTitles добавляет в индекс краткие и подробные карточки тайтлов,
+// вложенные в ответ m на любую глубину.
+func (s *Server) indexTitles(providerName, language string, m protoreflect.Message) {
+	switch msg := m.Interface().(type) {
+	case *pb.Movie:
+		s.index.Add(providerName, language, msg)
+		return
+	case *pb.TitleDetails:
+		s.index.Add(providerName, language, summaryFromDetails(msg))
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := range list.Len() {
+				s.indexTitles(providerName, language, list.Get(i).Message())
+			}
+		default:
+			s.indexTitles(providerName, language, v.Message())
+		}
+		return true
+	})
+}
+
+// withLocalResults дополняет результаты поиска источника тайтлами из
+// индекса. На первой странице тайтлы, которые почти точно совпадают с
+// запросом, но не нашлись у источника, ставятся первыми, а последние
+// результаты источника, не уместившиеся в страницу, отбрасываются; на
+// следующих страницах такие тайтлы убираются, чтобы не показывать их
+// дважды. Если источник не нашёл ничего или недоступен, первая страница
+// целиком состоит из тайтлов индекса.
+func (s *Server) withLocalResults(ctx context.Context, mediaType pb.MediaType, req *pb.SearchRequest, resp *pb.SearchResponse, err error) (*pb.SearchResponse, error) {
+	if s.index == nil {
+		return resp, err
+	}
+	if req.GetPage() > 1 {
+		if err != nil {
+			return nil, err
+		}
+		return withoutLocalHits(resp, s.strongLocalHits(ctx, mediaType, req)), nil
+	}
+	if err != nil && !canServeStale(err) {
+		return nil, err
+	}
+
+	// Тайтлы из индекса должны быть того же источника, что и результаты:
+	// если ответил запасной, ищем среди его тайтлов.
+	hits := s.index.Search(s.answeredBy(ctx), mediaType, req.GetQuery(), req.GetLanguage(), localSearchLimit)
+	if err != nil {
+		if len(hits) == 0 {
+			return nil, err
+		}
+		log.Printf("Источник недоступен (%v), отдаю %d результатов поиска %q из локального индекса", err, len(hits), req.GetQuery())
+		resp = &pb.SearchResponse{Page: 1, TotalPages: 1}
+	}
+
+	var found []*pb.Movie
+	local := make(map[int64]bool)
+	for _, hit := range hits {
+		if hit.Score < strongLocalMatch && len(resp.GetResults()) > 0 {
+			break
+		}
+		local[hit.Title.GetId()] = true
+		if !slices.ContainsFunc(resp.GetResults(), func(m *pb.Movie) bool { return m.GetId() == hit.Title.GetId() }) {
+			found = append(found, hit.Title)
+		}
+	}
+	if len(found) == 0 {
+		return resp, nil
+	}
+
+	// Страница не должна вырасти: с конца убираются результаты источника,
+	// кроме найденных и в индексе — на следующих страницах их не будет.
+	results := append(found, resp.GetResults()...)
+	for i := len(results) - 1; i >= 0 && len(results) > searchPageSize; i-- {
+		if !local[results[i].GetId()] {
+			results = slices.Delete(results, i, i+1)
+		}
+	}
+	resp.Results = results
+	resp.Page = max(resp.GetPage(), 1)
+	resp.TotalPages = max(resp.GetTotalPages(), 1)
+	return resp, nil
+}
+
+// strongLocalHits возвращает ID тайтлов индекса, которые withLocalResults
+// ставит на первую страницу поиска req, когда источник что-то нашёл.
+func (s *Server) strongLocalHits(ctx context.Context, mediaType pb.MediaType, req *pb.SearchRequest) map[int64]bool {
+	ids := make(map[int64]bool)
+	for _, hit := range s.index.Search(s.answeredBy(ctx), mediaType, req.GetQuery(), req.GetLanguage(), localSearchLimit) {
+		if hit.Score < strongLocalMatch {
+			break
+		}
+		ids[hit.Title.GetId()] = true
+	}
+	return ids
+}
+
+// withoutLocalHits возвращает resp без тайтлов с ID из ids. Ответ
+// источника не изменяется: его могут разделять несколько запросов.
+func withoutLocalHits(resp *pb.SearchResponse, ids map[int64]bool) *pb.SearchResponse {
+	if !slices.ContainsFunc(resp.GetResults(), func(m *pb.Movie) bool { return ids[m.GetId()] }) {
+		return resp
+	}
+	out := proto.Clone(resp).(*pb.SearchResponse)
+	out.Results = slices.DeleteFunc(out.Results, func(m *pb.Movie) bool { return ids[m.GetId()] })
+	return out
+}
@@ -0,0 +1,73 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"github.com/waste3d/Hikari-Anime/metadata/provider/fake"
+	"github.com/waste3d/Hikari-Anime/metadata/searchindex"
+)
+
+// pagedSearch отдаёт на поиск сериалов страницы по searchPageSize
+// тайтлов с ID 1, 2, … из results.
+type pagedSearch struct {
+	*fake.Provider
+	results []*pb.Movie
+}
+
+func (p *pagedSearch) SearchTVShows(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	start := min(int(page-1)*searchPageSize, len(p.results))
+	end := min(start+searchPageSize, len(p.results))
+	return &provider.MoviePage{
+		Results:    slices.Clone(p.results[start:end]),
+		Page:       page,
+		TotalPages: int32((len(p.results) + searchPageSize - 1) / searchPageSize),
+	}, nil
+}
+
+func TestWithLocalResultsPages(t *testing.T) {
+	const tv = pb.MediaType_MEDIA_TYPE_TV
+
+	p := &pagedSearch{Provider: fake.New()}
+	for id := range int64(2 * searchPageSize) {
+		p.results = append(p.results, &pb.Movie{Id: id + 1, MediaType: tv, Title: fmt.Sprintf("Titan %d", id+1)})
+	}
+	ix := searchindex.New(100)
+	// Тайтл, которого нет в выдаче источника, и тайтл со второй страницы.
+	ix.Add("fake", "en", &pb.Movie{Id: 1000, MediaType: tv, Title: "Shingeki no Kyojin"})
+	ix.Add("fake", "en", &pb.Movie{Id: 25, MediaType: tv, Title: "Shingeki no Kyojin: The Final Season"})
+	s := NewServer(p, WithSearchIndex(ix))
+
+	search := func(page int32) []int64 {
+		t.Helper()
+		resp, err := s.SearchTVShows(context.Background(), &pb.SearchRequest{Query: "Shingeki no Kyojin", Page: page, Language: "en"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var ids []int64
+		for _, m := range resp.GetResults() {
+			ids = append(ids, m.GetId())
+		}
+		return ids
+	}
+
+	first, second := search(1), search(2)
+	if len(first) != searchPageSize {
+		t.Errorf("на первой странице %d тайтлов, want %d: %v", len(first), searchPageSize, first)
+	}
+	if !slices.Equal(first[:2], []int64{1000, 25}) {
+		t.Errorf("первая страница начинается с %v, want [1000 25]", first[:2])
+	}
+	if len(second) != searchPageSize-1 || slices.Contains(second, 25) {
+		t.Errorf("вторая страница = %v, want без тайтла 25 с первой", second)
+	}
+	for _, id := range second {
+		if slices.Contains(first, id) {
+			t.Errorf("тайтл %d на обеих страницах", id)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package metadata
+
+import (
+	"context"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetCredits(ctx context.Context, req *pb.GetCreditsRequest) (*pb.CreditsResponse, error) {
+	p, id, err := s.titleProvider(ctx, req.GetMediaType(), req.GetTitleId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := p.Credits(ctx, req.GetMediaType(), id, req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreditsResponse{
+		Cast: credits.Cast,
+		Crew: credits.Crew,
+	}, nil
+}
+
+func (s *Server) GetCharacters(ctx context.Context, req *pb.GetCharactersRequest) (*pb.CharactersResponse, error) {
+	p, id, err := s.titleProvider(ctx, req.GetMediaType(), req.GetTitleId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	characters, err := p.Characters(ctx, req.GetMediaType(), id, max(req.GetPage(), 1), req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CharactersResponse{
+		Results:      characters.Results,
+		Page:         characters.Page,
+		TotalPages:   characters.TotalPages,
+		TotalResults: characters.TotalResults,
+	}, nil
+}
+
+func (s *Server) GetPerson(ctx context.Context, req *pb.GetPersonRequest) (*pb.PersonDetails, error) {
+	if req.GetPersonId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID человека (person_id) не может быть равен 0")
+	}
+	return s.providerFor(ctx).Person(ctx, req.GetPersonId(), req.GetLanguage())
+}
@@ -0,0 +1,83 @@
+package proto
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Перечисления аниме-методов отдаются в JSON строками без префикса в
+// нижнем регистре: "winter", "tv_short", "not_yet_aired".
+
+func (x AnimeSeason) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "ANIME_SEASON_", x == AnimeSeason_ANIME_SEASON_UNSPECIFIED), nil
+}
+
+func (x *AnimeSeason) UnmarshalText(text []byte) error {
+	v, err := parseEnum(string(text), "ANIME_SEASON_", AnimeSeason_value)
+	*x = AnimeSeason(v)
+	return err
+}
+
+func (x TitleFormat) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "TITLE_FORMAT_", x == TitleFormat_TITLE_FORMAT_UNSPECIFIED), nil
+}
+
+func (x *TitleFormat) UnmarshalText(text []byte) error {
+	v, err := parseEnum(string(text), "TITLE_FORMAT_", TitleFormat_value)
+	*x = TitleFormat(v)
+	return err
+}
+
+func (x AiringStatus) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "AIRING_STATUS_", x == AiringStatus_AIRING_STATUS_UNSPECIFIED), nil
+}
+
+func (x *AiringStatus) UnmarshalText(text []byte) error {
+	v, err := parseEnum(string(text), "AIRING_STATUS_", AiringStatus_value)
+	*x = AiringStatus(v)
+	return err
+}
+
+func enumText(name, prefix string, unspecified bool) []byte {
+	if unspecified {
+		return []byte("")
+	}
+	return []byte(strings.ToLower(strings.TrimPrefix(name, prefix)))
+}
+
+func parseEnum(text, prefix string, values map[string]int32) (int32, error) {
+	if text == "" {
+		return 0, nil
+	}
+	v, ok := values[prefix+strings.ToUpper(text)]
+	if !ok || v == 0 {
+		return 0, fmt.Errorf("неизвестное значение %q", text)
+	}
+	return v, nil
+}
+
+func (x RelationType) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "RELATION_TYPE_", x == RelationType_RELATION_TYPE_UNSPECIFIED), nil
+}
+
+func (x CharacterRole) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "CHARACTER_ROLE_", x == CharacterRole_CHARACTER_ROLE_UNSPECIFIED), nil
+}
+
+func (x VideoType) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "VIDEO_TYPE_", x == VideoType_VIDEO_TYPE_UNSPECIFIED), nil
+}
+
+func (x TimeWindow) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "TIME_WINDOW_", x == TimeWindow_TIME_WINDOW_UNSPECIFIED), nil
+}
+
+func (x *TimeWindow) UnmarshalText(text []byte) error {
+	v, err := parseEnum(string(text), "TIME_WINDOW_", TimeWindow_value)
+	*x = TimeWindow(v)
+	return err
+}
+
+func (x ImageKind) MarshalText() ([]byte, error) {
+	return enumText(x.String(), "IMAGE_KIND_", x == ImageKind_IMAGE_KIND_UNSPECIFIED), nil
+}
@@ -0,0 +1,34 @@
+package proto
+
+import "fmt"
+
+// MarshalText отдаёт MediaType в JSON-ответах шлюза строкой "movie"/"tv"/"person"
+// вместо номера значения перечисления.
+func (x MediaType) MarshalText() ([]byte, error) {
+	switch x {
+	case MediaType_MEDIA_TYPE_MOVIE:
+		return []byte("movie"), nil
+	case MediaType_MEDIA_TYPE_TV:
+		return []byte("tv"), nil
+	case MediaType_MEDIA_TYPE_PERSON:
+		return []byte("person"), nil
+	default:
+		return []byte(""), nil
+	}
+}
+
+func (x *MediaType) UnmarshalText(text []byte) error {
+	switch string(text) {
+	case "movie":
+		*x = MediaType_MEDIA_TYPE_MOVIE
+	case "tv":
+		*x = MediaType_MEDIA_TYPE_TV
+	case "person":
+		*x = MediaType_MEDIA_TYPE_PERSON
+	case "":
+		*x = MediaType_MEDIA_TYPE_UNSPECIFIED
+	default:
+		return fmt.Errorf("неизвестный тип контента %q", text)
+	}
+	return nil
+}
@@ -21,6 +21,597 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Тип контента
+type MediaType int32
+
+const (
+	MediaType_MEDIA_TYPE_UNSPECIFIED MediaType = 0
+	MediaType_MEDIA_TYPE_MOVIE       MediaType = 1
+	MediaType_MEDIA_TYPE_TV          MediaType = 2
+	MediaType_MEDIA_TYPE_PERSON      MediaType = 3
+)
+
+// Enum value maps for MediaType.
+var (
+	MediaType_name = map[int32]string{
+		0: "MEDIA_TYPE_UNSPECIFIED",
+		1: "MEDIA_TYPE_MOVIE",
+		2: "MEDIA_TYPE_TV",
+		3: "MEDIA_TYPE_PERSON",
+	}
+	MediaType_value = map[string]int32{
+		"MEDIA_TYPE_UNSPECIFIED": 0,
+		"MEDIA_TYPE_MOVIE":       1,
+		"MEDIA_TYPE_TV":          2,
+		"MEDIA_TYPE_PERSON":      3,
+	}
+)
+
+func (x MediaType) Enum() *MediaType {
+	p := new(MediaType)
+	*p = x
+	return p
+}
+
+func (x MediaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MediaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[0].Descriptor()
+}
+
+func (MediaType) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[0]
+}
+
+func (x MediaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MediaType.Descriptor instead.
+func (MediaType) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{0}
+}
+
+// Вид изображения: от него зависят доступные размеры
+type ImageKind int32
+
+const (
+	ImageKind_IMAGE_KIND_UNSPECIFIED ImageKind = 0
+	ImageKind_IMAGE_KIND_POSTER      ImageKind = 1
+	ImageKind_IMAGE_KIND_BACKDROP    ImageKind = 2
+	ImageKind_IMAGE_KIND_PROFILE     ImageKind = 3 // Фото человека или персонажа
+	ImageKind_IMAGE_KIND_STILL       ImageKind = 4 // Кадр из эпизода
+)
+
+// Enum value maps for ImageKind.
+var (
+	ImageKind_name = map[int32]string{
+		0: "IMAGE_KIND_UNSPECIFIED",
+		1: "IMAGE_KIND_POSTER",
+		2: "IMAGE_KIND_BACKDROP",
+		3: "IMAGE_KIND_PROFILE",
+		4: "IMAGE_KIND_STILL",
+	}
+	ImageKind_value = map[string]int32{
+		"IMAGE_KIND_UNSPECIFIED": 0,
+		"IMAGE_KIND_POSTER":      1,
+		"IMAGE_KIND_BACKDROP":    2,
+		"IMAGE_KIND_PROFILE":     3,
+		"IMAGE_KIND_STILL":       4,
+	}
+)
+
+func (x ImageKind) Enum() *ImageKind {
+	p := new(ImageKind)
+	*p = x
+	return p
+}
+
+func (x ImageKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImageKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[1].Descriptor()
+}
+
+func (ImageKind) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[1]
+}
+
+func (x ImageKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImageKind.Descriptor instead.
+func (ImageKind) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{1}
+}
+
+// Порядок сортировки подборок
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED       SortOrder = 0 // По популярности
+	SortOrder_SORT_ORDER_POPULARITY_DESC   SortOrder = 1
+	SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC SortOrder = 2
+	SortOrder_SORT_ORDER_RELEASE_DATE_DESC SortOrder = 3
+	SortOrder_SORT_ORDER_RELEASE_DATE_ASC  SortOrder = 4
+	SortOrder_SORT_ORDER_TITLE_ASC         SortOrder = 5
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_POPULARITY_DESC",
+		2: "SORT_ORDER_VOTE_AVERAGE_DESC",
+		3: "SORT_ORDER_RELEASE_DATE_DESC",
+		4: "SORT_ORDER_RELEASE_DATE_ASC",
+		5: "SORT_ORDER_TITLE_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED":       0,
+		"SORT_ORDER_POPULARITY_DESC":   1,
+		"SORT_ORDER_VOTE_AVERAGE_DESC": 2,
+		"SORT_ORDER_RELEASE_DATE_DESC": 3,
+		"SORT_ORDER_RELEASE_DATE_ASC":  4,
+		"SORT_ORDER_TITLE_ASC":         5,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[2].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[2]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+// Период, за который считается рост популярности
+type TimeWindow int32
+
+const (
+	TimeWindow_TIME_WINDOW_UNSPECIFIED TimeWindow = 0 // Неделя
+	TimeWindow_TIME_WINDOW_DAY         TimeWindow = 1
+	TimeWindow_TIME_WINDOW_WEEK        TimeWindow = 2
+)
+
+// Enum value maps for TimeWindow.
+var (
+	TimeWindow_name = map[int32]string{
+		0: "TIME_WINDOW_UNSPECIFIED",
+		1: "TIME_WINDOW_DAY",
+		2: "TIME_WINDOW_WEEK",
+	}
+	TimeWindow_value = map[string]int32{
+		"TIME_WINDOW_UNSPECIFIED": 0,
+		"TIME_WINDOW_DAY":         1,
+		"TIME_WINDOW_WEEK":        2,
+	}
+)
+
+func (x TimeWindow) Enum() *TimeWindow {
+	p := new(TimeWindow)
+	*p = x
+	return p
+}
+
+func (x TimeWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[3].Descriptor()
+}
+
+func (TimeWindow) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[3]
+}
+
+func (x TimeWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeWindow.Descriptor instead.
+func (TimeWindow) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+// Сезон года в аниме-индустрии: зима — январь–март, весна — апрель–июнь,
+// лето — июль–сентябрь, осень — октябрь–декабрь
+type AnimeSeason int32
+
+const (
+	AnimeSeason_ANIME_SEASON_UNSPECIFIED AnimeSeason = 0
+	AnimeSeason_ANIME_SEASON_WINTER      AnimeSeason = 1
+	AnimeSeason_ANIME_SEASON_SPRING      AnimeSeason = 2
+	AnimeSeason_ANIME_SEASON_SUMMER      AnimeSeason = 3
+	AnimeSeason_ANIME_SEASON_FALL        AnimeSeason = 4
+)
+
+// Enum value maps for AnimeSeason.
+var (
+	AnimeSeason_name = map[int32]string{
+		0: "ANIME_SEASON_UNSPECIFIED",
+		1: "ANIME_SEASON_WINTER",
+		2: "ANIME_SEASON_SPRING",
+		3: "ANIME_SEASON_SUMMER",
+		4: "ANIME_SEASON_FALL",
+	}
+	AnimeSeason_value = map[string]int32{
+		"ANIME_SEASON_UNSPECIFIED": 0,
+		"ANIME_SEASON_WINTER":      1,
+		"ANIME_SEASON_SPRING":      2,
+		"ANIME_SEASON_SUMMER":      3,
+		"ANIME_SEASON_FALL":        4,
+	}
+)
+
+func (x AnimeSeason) Enum() *AnimeSeason {
+	p := new(AnimeSeason)
+	*p = x
+	return p
+}
+
+func (x AnimeSeason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnimeSeason) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[4].Descriptor()
+}
+
+func (AnimeSeason) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[4]
+}
+
+func (x AnimeSeason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnimeSeason.Descriptor instead.
+func (AnimeSeason) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+// Формат выпуска тайтла
+type TitleFormat int32
+
+const (
+	TitleFormat_TITLE_FORMAT_UNSPECIFIED TitleFormat = 0
+	TitleFormat_TITLE_FORMAT_TV          TitleFormat = 1
+	TitleFormat_TITLE_FORMAT_TV_SHORT    TitleFormat = 2 // Короткие серии, до ~15 минут
+	TitleFormat_TITLE_FORMAT_MOVIE       TitleFormat = 3
+	TitleFormat_TITLE_FORMAT_SPECIAL     TitleFormat = 4
+	TitleFormat_TITLE_FORMAT_OVA         TitleFormat = 5
+	TitleFormat_TITLE_FORMAT_ONA         TitleFormat = 6 // Премьера в интернете
+	TitleFormat_TITLE_FORMAT_MUSIC       TitleFormat = 7
+)
+
+// Enum value maps for TitleFormat.
+var (
+	TitleFormat_name = map[int32]string{
+		0: "TITLE_FORMAT_UNSPECIFIED",
+		1: "TITLE_FORMAT_TV",
+		2: "TITLE_FORMAT_TV_SHORT",
+		3: "TITLE_FORMAT_MOVIE",
+		4: "TITLE_FORMAT_SPECIAL",
+		5: "TITLE_FORMAT_OVA",
+		6: "TITLE_FORMAT_ONA",
+		7: "TITLE_FORMAT_MUSIC",
+	}
+	TitleFormat_value = map[string]int32{
+		"TITLE_FORMAT_UNSPECIFIED": 0,
+		"TITLE_FORMAT_TV":          1,
+		"TITLE_FORMAT_TV_SHORT":    2,
+		"TITLE_FORMAT_MOVIE":       3,
+		"TITLE_FORMAT_SPECIAL":     4,
+		"TITLE_FORMAT_OVA":         5,
+		"TITLE_FORMAT_ONA":         6,
+		"TITLE_FORMAT_MUSIC":       7,
+	}
+)
+
+func (x TitleFormat) Enum() *TitleFormat {
+	p := new(TitleFormat)
+	*p = x
+	return p
+}
+
+func (x TitleFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TitleFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[5].Descriptor()
+}
+
+func (TitleFormat) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[5]
+}
+
+func (x TitleFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TitleFormat.Descriptor instead.
+func (TitleFormat) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{5}
+}
+
+// Статус выхода тайтла
+type AiringStatus int32
+
+const (
+	AiringStatus_AIRING_STATUS_UNSPECIFIED   AiringStatus = 0
+	AiringStatus_AIRING_STATUS_NOT_YET_AIRED AiringStatus = 1
+	AiringStatus_AIRING_STATUS_AIRING        AiringStatus = 2
+	AiringStatus_AIRING_STATUS_FINISHED      AiringStatus = 3
+	AiringStatus_AIRING_STATUS_CANCELLED     AiringStatus = 4
+	AiringStatus_AIRING_STATUS_HIATUS        AiringStatus = 5
+)
+
+// Enum value maps for AiringStatus.
+var (
+	AiringStatus_name = map[int32]string{
+		0: "AIRING_STATUS_UNSPECIFIED",
+		1: "AIRING_STATUS_NOT_YET_AIRED",
+		2: "AIRING_STATUS_AIRING",
+		3: "AIRING_STATUS_FINISHED",
+		4: "AIRING_STATUS_CANCELLED",
+		5: "AIRING_STATUS_HIATUS",
+	}
+	AiringStatus_value = map[string]int32{
+		"AIRING_STATUS_UNSPECIFIED":   0,
+		"AIRING_STATUS_NOT_YET_AIRED": 1,
+		"AIRING_STATUS_AIRING":        2,
+		"AIRING_STATUS_FINISHED":      3,
+		"AIRING_STATUS_CANCELLED":     4,
+		"AIRING_STATUS_HIATUS":        5,
+	}
+)
+
+func (x AiringStatus) Enum() *AiringStatus {
+	p := new(AiringStatus)
+	*p = x
+	return p
+}
+
+func (x AiringStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AiringStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[6].Descriptor()
+}
+
+func (AiringStatus) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[6]
+}
+
+func (x AiringStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AiringStatus.Descriptor instead.
+func (AiringStatus) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{6}
+}
+
+// Тип связи между тайтлами: кем связанный тайтл приходится исходному
+type RelationType int32
+
+const (
+	RelationType_RELATION_TYPE_UNSPECIFIED      RelationType = 0
+	RelationType_RELATION_TYPE_SEQUEL           RelationType = 1
+	RelationType_RELATION_TYPE_PREQUEL          RelationType = 2
+	RelationType_RELATION_TYPE_PARENT           RelationType = 3 // Основная история для побочной
+	RelationType_RELATION_TYPE_SIDE_STORY       RelationType = 4
+	RelationType_RELATION_TYPE_SPIN_OFF         RelationType = 5
+	RelationType_RELATION_TYPE_MOVIE_ADAPTATION RelationType = 6 // Фильм, пересказывающий сериал
+	RelationType_RELATION_TYPE_SUMMARY          RelationType = 7 // Рекап, компиляция
+	RelationType_RELATION_TYPE_ALTERNATIVE      RelationType = 8 // Другая экранизация той же истории
+	RelationType_RELATION_TYPE_CHARACTER        RelationType = 9 // Общие персонажи
+	RelationType_RELATION_TYPE_OTHER            RelationType = 10
+)
+
+// Enum value maps for RelationType.
+var (
+	RelationType_name = map[int32]string{
+		0:  "RELATION_TYPE_UNSPECIFIED",
+		1:  "RELATION_TYPE_SEQUEL",
+		2:  "RELATION_TYPE_PREQUEL",
+		3:  "RELATION_TYPE_PARENT",
+		4:  "RELATION_TYPE_SIDE_STORY",
+		5:  "RELATION_TYPE_SPIN_OFF",
+		6:  "RELATION_TYPE_MOVIE_ADAPTATION",
+		7:  "RELATION_TYPE_SUMMARY",
+		8:  "RELATION_TYPE_ALTERNATIVE",
+		9:  "RELATION_TYPE_CHARACTER",
+		10: "RELATION_TYPE_OTHER",
+	}
+	RelationType_value = map[string]int32{
+		"RELATION_TYPE_UNSPECIFIED":      0,
+		"RELATION_TYPE_SEQUEL":           1,
+		"RELATION_TYPE_PREQUEL":          2,
+		"RELATION_TYPE_PARENT":           3,
+		"RELATION_TYPE_SIDE_STORY":       4,
+		"RELATION_TYPE_SPIN_OFF":         5,
+		"RELATION_TYPE_MOVIE_ADAPTATION": 6,
+		"RELATION_TYPE_SUMMARY":          7,
+		"RELATION_TYPE_ALTERNATIVE":      8,
+		"RELATION_TYPE_CHARACTER":        9,
+		"RELATION_TYPE_OTHER":            10,
+	}
+)
+
+func (x RelationType) Enum() *RelationType {
+	p := new(RelationType)
+	*p = x
+	return p
+}
+
+func (x RelationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RelationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[7].Descriptor()
+}
+
+func (RelationType) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[7]
+}
+
+func (x RelationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RelationType.Descriptor instead.
+func (RelationType) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{7}
+}
+
+// Значимость персонажа в сюжете
+type CharacterRole int32
+
+const (
+	CharacterRole_CHARACTER_ROLE_UNSPECIFIED CharacterRole = 0
+	CharacterRole_CHARACTER_ROLE_MAIN        CharacterRole = 1
+	CharacterRole_CHARACTER_ROLE_SUPPORTING  CharacterRole = 2
+	CharacterRole_CHARACTER_ROLE_BACKGROUND  CharacterRole = 3
+)
+
+// Enum value maps for CharacterRole.
+var (
+	CharacterRole_name = map[int32]string{
+		0: "CHARACTER_ROLE_UNSPECIFIED",
+		1: "CHARACTER_ROLE_MAIN",
+		2: "CHARACTER_ROLE_SUPPORTING",
+		3: "CHARACTER_ROLE_BACKGROUND",
+	}
+	CharacterRole_value = map[string]int32{
+		"CHARACTER_ROLE_UNSPECIFIED": 0,
+		"CHARACTER_ROLE_MAIN":        1,
+		"CHARACTER_ROLE_SUPPORTING":  2,
+		"CHARACTER_ROLE_BACKGROUND":  3,
+	}
+)
+
+func (x CharacterRole) Enum() *CharacterRole {
+	p := new(CharacterRole)
+	*p = x
+	return p
+}
+
+func (x CharacterRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CharacterRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[8].Descriptor()
+}
+
+func (CharacterRole) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[8]
+}
+
+func (x CharacterRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CharacterRole.Descriptor instead.
+func (CharacterRole) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{8}
+}
+
+// Вид видео
+type VideoType int32
+
+const (
+	VideoType_VIDEO_TYPE_UNSPECIFIED       VideoType = 0
+	VideoType_VIDEO_TYPE_TRAILER           VideoType = 1
+	VideoType_VIDEO_TYPE_TEASER            VideoType = 2
+	VideoType_VIDEO_TYPE_OPENING           VideoType = 3 // Опенинг без титров
+	VideoType_VIDEO_TYPE_CLIP              VideoType = 4
+	VideoType_VIDEO_TYPE_FEATURETTE        VideoType = 5
+	VideoType_VIDEO_TYPE_BEHIND_THE_SCENES VideoType = 6
+	VideoType_VIDEO_TYPE_BLOOPERS          VideoType = 7
+)
+
+// Enum value maps for VideoType.
+var (
+	VideoType_name = map[int32]string{
+		0: "VIDEO_TYPE_UNSPECIFIED",
+		1: "VIDEO_TYPE_TRAILER",
+		2: "VIDEO_TYPE_TEASER",
+		3: "VIDEO_TYPE_OPENING",
+		4: "VIDEO_TYPE_CLIP",
+		5: "VIDEO_TYPE_FEATURETTE",
+		6: "VIDEO_TYPE_BEHIND_THE_SCENES",
+		7: "VIDEO_TYPE_BLOOPERS",
+	}
+	VideoType_value = map[string]int32{
+		"VIDEO_TYPE_UNSPECIFIED":       0,
+		"VIDEO_TYPE_TRAILER":           1,
+		"VIDEO_TYPE_TEASER":            2,
+		"VIDEO_TYPE_OPENING":           3,
+		"VIDEO_TYPE_CLIP":              4,
+		"VIDEO_TYPE_FEATURETTE":        5,
+		"VIDEO_TYPE_BEHIND_THE_SCENES": 6,
+		"VIDEO_TYPE_BLOOPERS":          7,
+	}
+)
+
+func (x VideoType) Enum() *VideoType {
+	p := new(VideoType)
+	*p = x
+	return p
+}
+
+func (x VideoType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VideoType) Descriptor() protoreflect.EnumDescriptor {
+	return file_metadata_proto_metadata_proto_enumTypes[9].Descriptor()
+}
+
+func (VideoType) Type() protoreflect.EnumType {
+	return &file_metadata_proto_metadata_proto_enumTypes[9]
+}
+
+func (x VideoType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VideoType.Descriptor instead.
+func (VideoType) EnumDescriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{9}
+}
+
 // Запрос на получение популярных фильмов
 type GetPopularMoviesRequest struct {
 	state         protoimpl.MessageState
@@ -149,6 +740,9 @@ type GetMovieByIDRequest struct {
 
 	MovieId  int64  `protobuf:"varint,1,opt,name=movie_id,json=movieId,proto3" json:"movie_id,omitempty"`
 	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	// ID с пространством имён, например "anilist:21" или "mal:1535".
+	// Если задан, movie_id не используется.
+	Id string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *GetMovieByIDRequest) Reset() {
@@ -197,6 +791,13 @@ func (x *GetMovieByIDRequest) GetLanguage() string {
 	return ""
 }
 
+func (x *GetMovieByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 // Запрос на поиск
 type SearchRequest struct {
 	state         protoimpl.MessageState
@@ -261,6 +862,7 @@ func (x *SearchRequest) GetLanguage() string {
 	return ""
 }
 
+// Ответ с результатами поиска
 type SearchResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -324,19 +926,26 @@ func (x *SearchResponse) GetTotalPages() int32 {
 	return 0
 }
 
-// Основная структура для фильма/сериала
+// Краткая карточка фильма/сериала для списков
 type Movie struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id            int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	OriginalTitle string  `protobuf:"bytes,3,opt,name=original_title,json=originalTitle,proto3" json:"original_title,omitempty"`
-	Overview      string  `protobuf:"bytes,4,opt,name=overview,proto3" json:"overview,omitempty"`
-	PosterPath    string  `protobuf:"bytes,5,opt,name=poster_path,json=posterPath,proto3" json:"poster_path,omitempty"`
-	ReleaseDate   string  `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	VoteAverage   float64 `protobuf:"fixed64,7,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	Id            int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string    `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	OriginalTitle string    `protobuf:"bytes,3,opt,name=original_title,json=originalTitle,proto3" json:"original_title,omitempty"`
+	Overview      string    `protobuf:"bytes,4,opt,name=overview,proto3" json:"overview,omitempty"`
+	PosterPath    string    `protobuf:"bytes,5,opt,name=poster_path,json=posterPath,proto3" json:"poster_path,omitempty"` // Устаревшее: адрес в размере w500, используйте poster
+	ReleaseDate   string    `protobuf:"bytes,6,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	VoteAverage   float64   `protobuf:"fixed64,7,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	MediaType     MediaType `protobuf:"varint,8,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"`
+	Popularity    float64   `protobuf:"fixed64,9,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	Poster        *Image    `protobuf:"bytes,10,opt,name=poster,proto3" json:"poster,omitempty"`
+	Backdrop      *Image    `protobuf:"bytes,11,opt,name=backdrop,proto3" json:"backdrop,omitempty"`
+	// Другие названия: синонимы, ромадзи, названия на других языках.
+	// Заполняется, только если источник отдаёт их в списках.
+	AlternativeTitles []string `protobuf:"bytes,12,rep,name=alternative_titles,json=alternativeTitles,proto3" json:"alternative_titles,omitempty"`
 }
 
 func (x *Movie) Reset() {
@@ -420,127 +1029,5782 @@ func (x *Movie) GetVoteAverage() float64 {
 	return 0
 }
 
-var File_metadata_proto_metadata_proto protoreflect.FileDescriptor
+func (x *Movie) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
 
-var file_metadata_proto_metadata_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
-	0x75, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76,
-	0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
-	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
-	0x22, 0x4c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x55,
-	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
-	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x76, 0x69,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72,
-	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
-	0x65, 0x32, 0xb3, 0x02, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75,
-	0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d,
-	0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c,
-	0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44,
-	0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65,
-	0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73,
-	0x12, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x56, 0x53,
-	0x68, 0x6f, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x75, 0x72, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x2f, 0x68, 0x69, 0x6b, 0x61, 0x72, 0x69, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Movie) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
 }
 
-var (
-	file_metadata_proto_metadata_proto_rawDescOnce sync.Once
-	file_metadata_proto_metadata_proto_rawDescData = file_metadata_proto_metadata_proto_rawDesc
-)
+func (x *Movie) GetPoster() *Image {
+	if x != nil {
+		return x.Poster
+	}
+	return nil
+}
 
-func file_metadata_proto_metadata_proto_rawDescGZIP() []byte {
-	file_metadata_proto_metadata_proto_rawDescOnce.Do(func() {
-		file_metadata_proto_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_metadata_proto_metadata_proto_rawDescData)
-	})
-	return file_metadata_proto_metadata_proto_rawDescData
+func (x *Movie) GetBackdrop() *Image {
+	if x != nil {
+		return x.Backdrop
+	}
+	return nil
 }
 
-var file_metadata_proto_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
-var file_metadata_proto_metadata_proto_goTypes = []interface{}{
-	(*GetPopularMoviesRequest)(nil),  // 0: metadata.GetPopularMoviesRequest
-	(*GetPopularMoviesResponse)(nil), // 1: metadata.GetPopularMoviesResponse
-	(*GetMovieByIDRequest)(nil),      // 2: metadata.GetMovieByIDRequest
-	(*SearchRequest)(nil),            // 3: metadata.SearchRequest
-	(*SearchResponse)(nil),           // 4: metadata.SearchResponse
-	(*Movie)(nil),                    // 5: metadata.Movie
+func (x *Movie) GetAlternativeTitles() []string {
+	if x != nil {
+		return x.AlternativeTitles
+	}
+	return nil
 }
-var file_metadata_proto_metadata_proto_depIdxs = []int32{
-	5, // 0: metadata.GetPopularMoviesResponse.results:type_name -> metadata.Movie
-	5, // 1: metadata.SearchResponse.results:type_name -> metadata.Movie
-	0, // 2: metadata.MetadataService.GetPopularMovies:input_type -> metadata.GetPopularMoviesRequest
-	2, // 3: metadata.MetadataService.GetMovieByID:input_type -> metadata.GetMovieByIDRequest
-	3, // 4: metadata.MetadataService.SearchMovies:input_type -> metadata.SearchRequest
-	3, // 5: metadata.MetadataService.SearchTVShows:input_type -> metadata.SearchRequest
-	1, // 6: metadata.MetadataService.GetPopularMovies:output_type -> metadata.GetPopularMoviesResponse
-	5, // 7: metadata.MetadataService.GetMovieByID:output_type -> metadata.Movie
-	4, // 8: metadata.MetadataService.SearchMovies:output_type -> metadata.SearchResponse
-	4, // 9: metadata.MetadataService.SearchTVShows:output_type -> metadata.SearchResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Изображение в нескольких размерах. Если изображения нет, поле с ним
+// не заполняется.
+type Image struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind ImageKind `protobuf:"varint,1,opt,name=kind,proto3,enum=metadata.ImageKind" json:"kind,omitempty"`
+	// Путь в источнике: для TMDb — "/abc.jpg", для остальных — полный адрес
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Размер → адрес, например "w342" → "https://image.tmdb.org/t/p/w342/abc.jpg".
+	// Размеры выбираются gRPC-метаданными x-hikari-image-sizes
+	Urls map[string]string `protobuf:"bytes,3,rep,name=urls,proto3" json:"urls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Значение атрибута srcset тега <img> для размеров с известной шириной
+	Srcset string `protobuf:"bytes,4,opt,name=srcset,proto3" json:"srcset,omitempty"`
+	// Заглушка на время загрузки: BlurHash и цвета "#rrggbb". Вычисляются
+	// в фоне только для постеров, поэтому в первых ответах их может не быть
+	Blurhash      string `protobuf:"bytes,5,opt,name=blurhash,proto3" json:"blurhash,omitempty"`
+	DominantColor string `protobuf:"bytes,6,opt,name=dominant_color,json=dominantColor,proto3" json:"dominant_color,omitempty"` // Преобладающий цвет, например для фона карточки
+	AccentColor   string `protobuf:"bytes,7,opt,name=accent_color,json=accentColor,proto3" json:"accent_color,omitempty"`       // Самый заметный насыщенный цвет
 }
 
-func init() { file_metadata_proto_metadata_proto_init() }
-func file_metadata_proto_metadata_proto_init() {
-	if File_metadata_proto_metadata_proto != nil {
-		return
+func (x *Image) Reset() {
+	*x = Image{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_metadata_proto_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPopularMoviesRequest); i {
+}
+
+func (x *Image) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Image) ProtoMessage() {}
+
+func (x *Image) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Image.ProtoReflect.Descriptor instead.
+func (*Image) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Image) GetKind() ImageKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ImageKind_IMAGE_KIND_UNSPECIFIED
+}
+
+func (x *Image) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Image) GetUrls() map[string]string {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *Image) GetSrcset() string {
+	if x != nil {
+		return x.Srcset
+	}
+	return ""
+}
+
+func (x *Image) GetBlurhash() string {
+	if x != nil {
+		return x.Blurhash
+	}
+	return ""
+}
+
+func (x *Image) GetDominantColor() string {
+	if x != nil {
+		return x.DominantColor
+	}
+	return ""
+}
+
+func (x *Image) GetAccentColor() string {
+	if x != nil {
+		return x.AccentColor
+	}
+	return ""
+}
+
+// Жанр
+type Genre struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Genre) Reset() {
+	*x = Genre{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Genre) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Genre) ProtoMessage() {}
+
+func (x *Genre) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Genre.ProtoReflect.Descriptor instead.
+func (*Genre) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Genre) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Genre) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Подробная информация о фильме или сериале
+type TitleDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               int64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	MediaType        MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"`
+	Title            string    `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	OriginalTitle    string    `protobuf:"bytes,4,opt,name=original_title,json=originalTitle,proto3" json:"original_title,omitempty"`
+	Overview         string    `protobuf:"bytes,5,opt,name=overview,proto3" json:"overview,omitempty"`
+	Tagline          string    `protobuf:"bytes,6,opt,name=tagline,proto3" json:"tagline,omitempty"`
+	PosterPath       string    `protobuf:"bytes,7,opt,name=poster_path,json=posterPath,proto3" json:"poster_path,omitempty"`       // Устаревшее: используйте poster
+	BackdropPath     string    `protobuf:"bytes,8,opt,name=backdrop_path,json=backdropPath,proto3" json:"backdrop_path,omitempty"` // Устаревшее: используйте backdrop
+	ReleaseDate      string    `protobuf:"bytes,9,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`    // Для сериалов — дата выхода первого эпизода
+	Status           string    `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`                                // Например, "Released" или "Returning Series"
+	Genres           []*Genre  `protobuf:"bytes,11,rep,name=genres,proto3" json:"genres,omitempty"`
+	Runtime          int32     `protobuf:"varint,12,opt,name=runtime,proto3" json:"runtime,omitempty"` // Минуты; для сериалов — типичная длительность эпизода
+	OriginalLanguage string    `protobuf:"bytes,13,opt,name=original_language,json=originalLanguage,proto3" json:"original_language,omitempty"`
+	OriginCountry    []string  `protobuf:"bytes,14,rep,name=origin_country,json=originCountry,proto3" json:"origin_country,omitempty"` // Коды ISO 3166-1
+	Adult            bool      `protobuf:"varint,15,opt,name=adult,proto3" json:"adult,omitempty"`
+	VoteAverage      float64   `protobuf:"fixed64,16,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	VoteCount        int32     `protobuf:"varint,17,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	Popularity       float64   `protobuf:"fixed64,18,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	// Только для сериалов
+	LastAirDate      string    `protobuf:"bytes,19,opt,name=last_air_date,json=lastAirDate,proto3" json:"last_air_date,omitempty"`
+	NumberOfSeasons  int32     `protobuf:"varint,20,opt,name=number_of_seasons,json=numberOfSeasons,proto3" json:"number_of_seasons,omitempty"`
+	NumberOfEpisodes int32     `protobuf:"varint,21,opt,name=number_of_episodes,json=numberOfEpisodes,proto3" json:"number_of_episodes,omitempty"`
+	Seasons          []*Season `protobuf:"bytes,22,rep,name=seasons,proto3" json:"seasons,omitempty"` // Без списка эпизодов
+	// ID тайтла в других источниках: пространство имён → ID,
+	// например "anilist" → "21", "imdb" → "tt0388629"
+	ExternalIds map[string]string `protobuf:"bytes,23,rep,name=external_ids,json=externalIds,proto3" json:"external_ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Только для выходящих сериалов: ближайший эпизод
+	NextEpisode *AiringEpisode `protobuf:"bytes,24,opt,name=next_episode,json=nextEpisode,proto3" json:"next_episode,omitempty"`
+	// Поля, не переведённые на язык запроса и взятые из запасного языка:
+	// имя поля ("title", "overview", "tagline") → язык, например "en-US"
+	FallbackLanguages map[string]string `protobuf:"bytes,25,rep,name=fallback_languages,json=fallbackLanguages,proto3" json:"fallback_languages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Poster            *Image            `protobuf:"bytes,26,opt,name=poster,proto3" json:"poster,omitempty"`
+	Backdrop          *Image            `protobuf:"bytes,27,opt,name=backdrop,proto3" json:"backdrop,omitempty"`
+	// Другие названия: синонимы, ромадзи, названия на других языках.
+	AlternativeTitles []string `protobuf:"bytes,28,rep,name=alternative_titles,json=alternativeTitles,proto3" json:"alternative_titles,omitempty"`
+}
+
+func (x *TitleDetails) Reset() {
+	*x = TitleDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TitleDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TitleDetails) ProtoMessage() {}
+
+func (x *TitleDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TitleDetails.ProtoReflect.Descriptor instead.
+func (*TitleDetails) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TitleDetails) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *TitleDetails) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetOriginalTitle() string {
+	if x != nil {
+		return x.OriginalTitle
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetTagline() string {
+	if x != nil {
+		return x.Tagline
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetPosterPath() string {
+	if x != nil {
+		return x.PosterPath
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetBackdropPath() string {
+	if x != nil {
+		return x.BackdropPath
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetReleaseDate() string {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetGenres() []*Genre {
+	if x != nil {
+		return x.Genres
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetRuntime() int32 {
+	if x != nil {
+		return x.Runtime
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetOriginalLanguage() string {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetOriginCountry() []string {
+	if x != nil {
+		return x.OriginCountry
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetAdult() bool {
+	if x != nil {
+		return x.Adult
+	}
+	return false
+}
+
+func (x *TitleDetails) GetVoteAverage() float64 {
+	if x != nil {
+		return x.VoteAverage
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetLastAirDate() string {
+	if x != nil {
+		return x.LastAirDate
+	}
+	return ""
+}
+
+func (x *TitleDetails) GetNumberOfSeasons() int32 {
+	if x != nil {
+		return x.NumberOfSeasons
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetNumberOfEpisodes() int32 {
+	if x != nil {
+		return x.NumberOfEpisodes
+	}
+	return 0
+}
+
+func (x *TitleDetails) GetSeasons() []*Season {
+	if x != nil {
+		return x.Seasons
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetExternalIds() map[string]string {
+	if x != nil {
+		return x.ExternalIds
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetNextEpisode() *AiringEpisode {
+	if x != nil {
+		return x.NextEpisode
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetFallbackLanguages() map[string]string {
+	if x != nil {
+		return x.FallbackLanguages
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetPoster() *Image {
+	if x != nil {
+		return x.Poster
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetBackdrop() *Image {
+	if x != nil {
+		return x.Backdrop
+	}
+	return nil
+}
+
+func (x *TitleDetails) GetAlternativeTitles() []string {
+	if x != nil {
+		return x.AlternativeTitles
+	}
+	return nil
+}
+
+// Запрос объединённого поиска
+type MultiSearchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query      string      `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Page       int32       `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Language   string      `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	MediaTypes []MediaType `protobuf:"varint,4,rep,packed,name=media_types,json=mediaTypes,proto3,enum=metadata.MediaType" json:"media_types,omitempty"` // Пусто — искать везде
+}
+
+func (x *MultiSearchRequest) Reset() {
+	*x = MultiSearchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSearchRequest) ProtoMessage() {}
+
+func (x *MultiSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSearchRequest.ProtoReflect.Descriptor instead.
+func (*MultiSearchRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MultiSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MultiSearchRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *MultiSearchRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *MultiSearchRequest) GetMediaTypes() []MediaType {
+	if x != nil {
+		return x.MediaTypes
+	}
+	return nil
+}
+
+// Результаты объединённого поиска, отсортированные по score
+type MultiSearchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*SearchHit `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32        `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32        `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *MultiSearchResponse) Reset() {
+	*x = MultiSearchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSearchResponse) ProtoMessage() {}
+
+func (x *MultiSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSearchResponse.ProtoReflect.Descriptor instead.
+func (*MultiSearchResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MultiSearchResponse) GetResults() []*SearchHit {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *MultiSearchResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *MultiSearchResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *MultiSearchResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Один результат объединённого поиска
+type SearchHit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType MediaType      `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"`
+	Title     *Movie         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`   // Для фильмов и сериалов
+	Person    *PersonSummary `protobuf:"bytes,3,opt,name=person,proto3" json:"person,omitempty"` // Для людей
+	Score     float64        `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"` // Релевантность с учётом популярности, от 0 до 1
+}
+
+func (x *SearchHit) Reset() {
+	*x = SearchHit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchHit) ProtoMessage() {}
+
+func (x *SearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchHit.ProtoReflect.Descriptor instead.
+func (*SearchHit) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SearchHit) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *SearchHit) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *SearchHit) GetPerson() *PersonSummary {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *SearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Краткая карточка человека для списков
+type PersonSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ProfilePath        string   `protobuf:"bytes,3,opt,name=profile_path,json=profilePath,proto3" json:"profile_path,omitempty"`                        // Устаревшее: используйте profile
+	KnownForDepartment string   `protobuf:"bytes,4,opt,name=known_for_department,json=knownForDepartment,proto3" json:"known_for_department,omitempty"` // Например, "Acting"
+	Popularity         float64  `protobuf:"fixed64,5,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	KnownFor           []*Movie `protobuf:"bytes,6,rep,name=known_for,json=knownFor,proto3" json:"known_for,omitempty"`
+	Profile            *Image   `protobuf:"bytes,7,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *PersonSummary) Reset() {
+	*x = PersonSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonSummary) ProtoMessage() {}
+
+func (x *PersonSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonSummary.ProtoReflect.Descriptor instead.
+func (*PersonSummary) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PersonSummary) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PersonSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonSummary) GetProfilePath() string {
+	if x != nil {
+		return x.ProfilePath
+	}
+	return ""
+}
+
+func (x *PersonSummary) GetKnownForDepartment() string {
+	if x != nil {
+		return x.KnownForDepartment
+	}
+	return ""
+}
+
+func (x *PersonSummary) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *PersonSummary) GetKnownFor() []*Movie {
+	if x != nil {
+		return x.KnownFor
+	}
+	return nil
+}
+
+func (x *PersonSummary) GetProfile() *Image {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Запрос популярного аниме
+type GetPopularAnimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      int32     `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Language  string    `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	MediaType MediaType `protobuf:"varint,3,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильмы, сериалы или всё вместе (UNSPECIFIED)
+}
+
+func (x *GetPopularAnimeRequest) Reset() {
+	*x = GetPopularAnimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPopularAnimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPopularAnimeRequest) ProtoMessage() {}
+
+func (x *GetPopularAnimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPopularAnimeRequest.ProtoReflect.Descriptor instead.
+func (*GetPopularAnimeRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPopularAnimeRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPopularAnimeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetPopularAnimeRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+// Запрос подборки аниме
+type DiscoverAnimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page      int32     `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Language  string    `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	MediaType MediaType `protobuf:"varint,3,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильмы, сериалы или всё вместе (UNSPECIFIED)
+	SortBy    SortOrder `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=metadata.SortOrder" json:"sort_by,omitempty"`
+}
+
+func (x *DiscoverAnimeRequest) Reset() {
+	*x = DiscoverAnimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverAnimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverAnimeRequest) ProtoMessage() {}
+
+func (x *DiscoverAnimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverAnimeRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverAnimeRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiscoverAnimeRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DiscoverAnimeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *DiscoverAnimeRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *DiscoverAnimeRequest) GetSortBy() SortOrder {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+// Страница списка аниме
+type AnimeListResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*Movie `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32    `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32    `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *AnimeListResponse) Reset() {
+	*x = AnimeListResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnimeListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnimeListResponse) ProtoMessage() {}
+
+func (x *AnimeListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnimeListResponse.ProtoReflect.Descriptor instead.
+func (*AnimeListResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AnimeListResponse) GetResults() []*Movie {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AnimeListResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AnimeListResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *AnimeListResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос подборки по фильтрам. Нулевые значения фильтров — без ограничения
+type DiscoverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType        MediaType `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Page             int32     `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Language         string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	SortBy           SortOrder `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=metadata.SortOrder" json:"sort_by,omitempty"`
+	WithGenres       []int32   `protobuf:"varint,5,rep,packed,name=with_genres,json=withGenres,proto3" json:"with_genres,omitempty"`          // ID жанров TMDb, которые должны быть все
+	WithoutGenres    []int32   `protobuf:"varint,6,rep,packed,name=without_genres,json=withoutGenres,proto3" json:"without_genres,omitempty"` // ID жанров TMDb, которых быть не должно
+	YearFrom         int32     `protobuf:"varint,7,opt,name=year_from,json=yearFrom,proto3" json:"year_from,omitempty"`                       // Год выхода, включительно
+	YearTo           int32     `protobuf:"varint,8,opt,name=year_to,json=yearTo,proto3" json:"year_to,omitempty"`
+	MinRating        float64   `protobuf:"fixed64,9,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"` // Средняя оценка по шкале 0–10
+	MinVotes         int32     `protobuf:"varint,10,opt,name=min_votes,json=minVotes,proto3" json:"min_votes,omitempty"`
+	OriginalLanguage string    `protobuf:"bytes,11,opt,name=original_language,json=originalLanguage,proto3" json:"original_language,omitempty"` // Код ISO 639-1, например "ja"
+	MinRuntime       int32     `protobuf:"varint,12,opt,name=min_runtime,json=minRuntime,proto3" json:"min_runtime,omitempty"`                  // Длительность фильма или эпизода в минутах
+	MaxRuntime       int32     `protobuf:"varint,13,opt,name=max_runtime,json=maxRuntime,proto3" json:"max_runtime,omitempty"`
+}
+
+func (x *DiscoverRequest) Reset() {
+	*x = DiscoverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverRequest) ProtoMessage() {}
+
+func (x *DiscoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverRequest.ProtoReflect.Descriptor instead.
+func (*DiscoverRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DiscoverRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *DiscoverRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *DiscoverRequest) GetSortBy() SortOrder {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *DiscoverRequest) GetWithGenres() []int32 {
+	if x != nil {
+		return x.WithGenres
+	}
+	return nil
+}
+
+func (x *DiscoverRequest) GetWithoutGenres() []int32 {
+	if x != nil {
+		return x.WithoutGenres
+	}
+	return nil
+}
+
+func (x *DiscoverRequest) GetYearFrom() int32 {
+	if x != nil {
+		return x.YearFrom
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetYearTo() int32 {
+	if x != nil {
+		return x.YearTo
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetMinVotes() int32 {
+	if x != nil {
+		return x.MinVotes
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetOriginalLanguage() string {
+	if x != nil {
+		return x.OriginalLanguage
+	}
+	return ""
+}
+
+func (x *DiscoverRequest) GetMinRuntime() int32 {
+	if x != nil {
+		return x.MinRuntime
+	}
+	return 0
+}
+
+func (x *DiscoverRequest) GetMaxRuntime() int32 {
+	if x != nil {
+		return x.MaxRuntime
+	}
+	return 0
+}
+
+// Страница подборки
+type DiscoverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*Movie `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32    `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32    `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *DiscoverResponse) Reset() {
+	*x = DiscoverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscoverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscoverResponse) ProtoMessage() {}
+
+func (x *DiscoverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscoverResponse.ProtoReflect.Descriptor instead.
+func (*DiscoverResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DiscoverResponse) GetResults() []*Movie {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *DiscoverResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *DiscoverResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *DiscoverResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос трендов
+type GetTrendingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType  MediaType  `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильмы, сериалы или всё вместе (UNSPECIFIED)
+	TimeWindow TimeWindow `protobuf:"varint,2,opt,name=time_window,json=timeWindow,proto3,enum=metadata.TimeWindow" json:"time_window,omitempty"`
+	Page       int32      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Language   string     `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetTrendingRequest) Reset() {
+	*x = GetTrendingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrendingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrendingRequest) ProtoMessage() {}
+
+func (x *GetTrendingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrendingRequest.ProtoReflect.Descriptor instead.
+func (*GetTrendingRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetTrendingRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetTrendingRequest) GetTimeWindow() TimeWindow {
+	if x != nil {
+		return x.TimeWindow
+	}
+	return TimeWindow_TIME_WINDOW_UNSPECIFIED
+}
+
+func (x *GetTrendingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTrendingRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Страница трендов
+type TrendingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*Movie `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32    `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32    `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *TrendingResponse) Reset() {
+	*x = TrendingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrendingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingResponse) ProtoMessage() {}
+
+func (x *TrendingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingResponse.ProtoReflect.Descriptor instead.
+func (*TrendingResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TrendingResponse) GetResults() []*Movie {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *TrendingResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *TrendingResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *TrendingResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос сезонного чарта
+type GetSeasonalChartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year     int32         `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Season   AnimeSeason   `protobuf:"varint,2,opt,name=season,proto3,enum=metadata.AnimeSeason" json:"season,omitempty"`
+	Page     int32         `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Language string        `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Formats  []TitleFormat `protobuf:"varint,5,rep,packed,name=formats,proto3,enum=metadata.TitleFormat" json:"formats,omitempty"` // Пусто — все форматы
+	SortBy   SortOrder     `protobuf:"varint,6,opt,name=sort_by,json=sortBy,proto3,enum=metadata.SortOrder" json:"sort_by,omitempty"`
+}
+
+func (x *GetSeasonalChartRequest) Reset() {
+	*x = GetSeasonalChartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonalChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonalChartRequest) ProtoMessage() {}
+
+func (x *GetSeasonalChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonalChartRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonalChartRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetSeasonalChartRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetSeasonalChartRequest) GetSeason() AnimeSeason {
+	if x != nil {
+		return x.Season
+	}
+	return AnimeSeason_ANIME_SEASON_UNSPECIFIED
+}
+
+func (x *GetSeasonalChartRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetSeasonalChartRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetSeasonalChartRequest) GetFormats() []TitleFormat {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *GetSeasonalChartRequest) GetSortBy() SortOrder {
+	if x != nil {
+		return x.SortBy
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+// Тайтл в сезонном чарте
+type ChartEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title        *Movie       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Format       TitleFormat  `protobuf:"varint,2,opt,name=format,proto3,enum=metadata.TitleFormat" json:"format,omitempty"`
+	Episodes     int32        `protobuf:"varint,3,opt,name=episodes,proto3" json:"episodes,omitempty"` // 0 — число эпизодов ещё неизвестно
+	AiringStatus AiringStatus `protobuf:"varint,4,opt,name=airing_status,json=airingStatus,proto3,enum=metadata.AiringStatus" json:"airing_status,omitempty"`
+}
+
+func (x *ChartEntry) Reset() {
+	*x = ChartEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChartEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChartEntry) ProtoMessage() {}
+
+func (x *ChartEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChartEntry.ProtoReflect.Descriptor instead.
+func (*ChartEntry) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ChartEntry) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *ChartEntry) GetFormat() TitleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TitleFormat_TITLE_FORMAT_UNSPECIFIED
+}
+
+func (x *ChartEntry) GetEpisodes() int32 {
+	if x != nil {
+		return x.Episodes
+	}
+	return 0
+}
+
+func (x *ChartEntry) GetAiringStatus() AiringStatus {
+	if x != nil {
+		return x.AiringStatus
+	}
+	return AiringStatus_AIRING_STATUS_UNSPECIFIED
+}
+
+// Страница сезонного чарта
+type SeasonalChartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Year         int32         `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Season       AnimeSeason   `protobuf:"varint,2,opt,name=season,proto3,enum=metadata.AnimeSeason" json:"season,omitempty"`
+	Results      []*ChartEntry `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32         `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32         `protobuf:"varint,5,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32         `protobuf:"varint,6,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *SeasonalChartResponse) Reset() {
+	*x = SeasonalChartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SeasonalChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeasonalChartResponse) ProtoMessage() {}
+
+func (x *SeasonalChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeasonalChartResponse.ProtoReflect.Descriptor instead.
+func (*SeasonalChartResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SeasonalChartResponse) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SeasonalChartResponse) GetSeason() AnimeSeason {
+	if x != nil {
+		return x.Season
+	}
+	return AnimeSeason_ANIME_SEASON_UNSPECIFIED
+}
+
+func (x *SeasonalChartResponse) GetResults() []*ChartEntry {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SeasonalChartResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SeasonalChartResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *SeasonalChartResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос расписания выхода эпизодов
+type GetAiringScheduleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Даты YYYY-MM-DD в часовом поясе timezone, включительно.
+	// Пусто — с сегодняшнего дня на неделю вперёд.
+	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To       string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"` // Часовой пояс IANA, например "Europe/Moscow"; пусто — UTC
+	Page     int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Language string `protobuf:"bytes,5,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetAiringScheduleRequest) Reset() {
+	*x = GetAiringScheduleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAiringScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAiringScheduleRequest) ProtoMessage() {}
+
+func (x *GetAiringScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAiringScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetAiringScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAiringScheduleRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetAiringScheduleRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetAiringScheduleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetAiringScheduleRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetAiringScheduleRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Выход эпизода
+type AiringEpisode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title         *Movie `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`                                    // Сериал
+	SeasonNumber  int32  `protobuf:"varint,2,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"` // 0 — источник не делит сериал на сезоны
+	EpisodeNumber int32  `protobuf:"varint,3,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	Name          string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	AirsAtUnix    int64  `protobuf:"varint,5,opt,name=airs_at_unix,json=airsAtUnix,proto3" json:"airs_at_unix,omitempty"` // Время выхода, секунды Unix
+	// То же время в RFC 3339 в запрошенном часовом поясе; при date_only —
+	// только дата YYYY-MM-DD
+	AirsAt   string `protobuf:"bytes,6,opt,name=airs_at,json=airsAt,proto3" json:"airs_at,omitempty"`
+	DateOnly bool   `protobuf:"varint,7,opt,name=date_only,json=dateOnly,proto3" json:"date_only,omitempty"` // Источник знает только дату выхода, airs_at_unix — полночь UTC
+	Runtime  int32  `protobuf:"varint,8,opt,name=runtime,proto3" json:"runtime,omitempty"`                   // Длительность в минутах; 0 — неизвестна
+}
+
+func (x *AiringEpisode) Reset() {
+	*x = AiringEpisode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AiringEpisode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AiringEpisode) ProtoMessage() {}
+
+func (x *AiringEpisode) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AiringEpisode.ProtoReflect.Descriptor instead.
+func (*AiringEpisode) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AiringEpisode) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *AiringEpisode) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *AiringEpisode) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *AiringEpisode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AiringEpisode) GetAirsAtUnix() int64 {
+	if x != nil {
+		return x.AirsAtUnix
+	}
+	return 0
+}
+
+func (x *AiringEpisode) GetAirsAt() string {
+	if x != nil {
+		return x.AirsAt
+	}
+	return ""
+}
+
+func (x *AiringEpisode) GetDateOnly() bool {
+	if x != nil {
+		return x.DateOnly
+	}
+	return false
+}
+
+func (x *AiringEpisode) GetRuntime() int32 {
+	if x != nil {
+		return x.Runtime
+	}
+	return 0
+}
+
+// Страница расписания, отсортированная по времени выхода
+type AiringScheduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*AiringEpisode `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Timezone     string           `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Page         int32            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32            `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32            `protobuf:"varint,5,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *AiringScheduleResponse) Reset() {
+	*x = AiringScheduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AiringScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AiringScheduleResponse) ProtoMessage() {}
+
+func (x *AiringScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AiringScheduleResponse.ProtoReflect.Descriptor instead.
+func (*AiringScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AiringScheduleResponse) GetResults() []*AiringEpisode {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *AiringScheduleResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AiringScheduleResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AiringScheduleResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *AiringScheduleResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос на получение сериала по ID
+type GetTVShowByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TvId     int64  `protobuf:"varint,1,opt,name=tv_id,json=tvId,proto3" json:"tv_id,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Id       string `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`             // ID с пространством имён, как в GetMovieByIDRequest
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // Часовой пояс IANA для next_episode.airs_at; пусто — UTC
+}
+
+func (x *GetTVShowByIDRequest) Reset() {
+	*x = GetTVShowByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTVShowByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTVShowByIDRequest) ProtoMessage() {}
+
+func (x *GetTVShowByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTVShowByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetTVShowByIDRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetTVShowByIDRequest) GetTvId() int64 {
+	if x != nil {
+		return x.TvId
+	}
+	return 0
+}
+
+func (x *GetTVShowByIDRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetTVShowByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetTVShowByIDRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Запрос на получение сезона сериала
+type GetSeasonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TvId         int64  `protobuf:"varint,1,opt,name=tv_id,json=tvId,proto3" json:"tv_id,omitempty"`
+	SeasonNumber int32  `protobuf:"varint,2,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"` // 0 — спецвыпуски
+	Language     string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetSeasonRequest) Reset() {
+	*x = GetSeasonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSeasonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeasonRequest) ProtoMessage() {}
+
+func (x *GetSeasonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeasonRequest.ProtoReflect.Descriptor instead.
+func (*GetSeasonRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetSeasonRequest) GetTvId() int64 {
+	if x != nil {
+		return x.TvId
+	}
+	return 0
+}
+
+func (x *GetSeasonRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *GetSeasonRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Запрос на получение эпизода сериала
+type GetEpisodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TvId          int64  `protobuf:"varint,1,opt,name=tv_id,json=tvId,proto3" json:"tv_id,omitempty"`
+	SeasonNumber  int32  `protobuf:"varint,2,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	EpisodeNumber int32  `protobuf:"varint,3,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetEpisodeRequest) Reset() {
+	*x = GetEpisodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEpisodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEpisodeRequest) ProtoMessage() {}
+
+func (x *GetEpisodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEpisodeRequest.ProtoReflect.Descriptor instead.
+func (*GetEpisodeRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetEpisodeRequest) GetTvId() int64 {
+	if x != nil {
+		return x.TvId
+	}
+	return 0
+}
+
+func (x *GetEpisodeRequest) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *GetEpisodeRequest) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *GetEpisodeRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Сезон сериала
+type Season struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TvId         int64      `protobuf:"varint,2,opt,name=tv_id,json=tvId,proto3" json:"tv_id,omitempty"`
+	SeasonNumber int32      `protobuf:"varint,3,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	Name         string     `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Overview     string     `protobuf:"bytes,5,opt,name=overview,proto3" json:"overview,omitempty"`
+	AirDate      string     `protobuf:"bytes,6,opt,name=air_date,json=airDate,proto3" json:"air_date,omitempty"`
+	PosterPath   string     `protobuf:"bytes,7,opt,name=poster_path,json=posterPath,proto3" json:"poster_path,omitempty"` // Устаревшее: используйте poster
+	EpisodeCount int32      `protobuf:"varint,8,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"`
+	Episodes     []*Episode `protobuf:"bytes,9,rep,name=episodes,proto3" json:"episodes,omitempty"` // Заполняется только в GetSeason
+	Poster       *Image     `protobuf:"bytes,10,opt,name=poster,proto3" json:"poster,omitempty"`
+}
+
+func (x *Season) Reset() {
+	*x = Season{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Season) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Season) ProtoMessage() {}
+
+func (x *Season) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Season.ProtoReflect.Descriptor instead.
+func (*Season) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Season) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Season) GetTvId() int64 {
+	if x != nil {
+		return x.TvId
+	}
+	return 0
+}
+
+func (x *Season) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *Season) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Season) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *Season) GetAirDate() string {
+	if x != nil {
+		return x.AirDate
+	}
+	return ""
+}
+
+func (x *Season) GetPosterPath() string {
+	if x != nil {
+		return x.PosterPath
+	}
+	return ""
+}
+
+func (x *Season) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+func (x *Season) GetEpisodes() []*Episode {
+	if x != nil {
+		return x.Episodes
+	}
+	return nil
+}
+
+func (x *Season) GetPoster() *Image {
+	if x != nil {
+		return x.Poster
+	}
+	return nil
+}
+
+// Эпизод сериала
+type Episode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TvId          int64   `protobuf:"varint,2,opt,name=tv_id,json=tvId,proto3" json:"tv_id,omitempty"`
+	SeasonNumber  int32   `protobuf:"varint,3,opt,name=season_number,json=seasonNumber,proto3" json:"season_number,omitempty"`
+	EpisodeNumber int32   `protobuf:"varint,4,opt,name=episode_number,json=episodeNumber,proto3" json:"episode_number,omitempty"`
+	Name          string  `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Overview      string  `protobuf:"bytes,6,opt,name=overview,proto3" json:"overview,omitempty"`
+	AirDate       string  `protobuf:"bytes,7,opt,name=air_date,json=airDate,proto3" json:"air_date,omitempty"`
+	Runtime       int32   `protobuf:"varint,8,opt,name=runtime,proto3" json:"runtime,omitempty"`                     // Длительность в минутах
+	StillPath     string  `protobuf:"bytes,9,opt,name=still_path,json=stillPath,proto3" json:"still_path,omitempty"` // Устаревшее: используйте still
+	VoteAverage   float64 `protobuf:"fixed64,10,opt,name=vote_average,json=voteAverage,proto3" json:"vote_average,omitempty"`
+	Still         *Image  `protobuf:"bytes,11,opt,name=still,proto3" json:"still,omitempty"` // Кадр из эпизода
+}
+
+func (x *Episode) Reset() {
+	*x = Episode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Episode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Episode) ProtoMessage() {}
+
+func (x *Episode) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Episode.ProtoReflect.Descriptor instead.
+func (*Episode) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Episode) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Episode) GetTvId() int64 {
+	if x != nil {
+		return x.TvId
+	}
+	return 0
+}
+
+func (x *Episode) GetSeasonNumber() int32 {
+	if x != nil {
+		return x.SeasonNumber
+	}
+	return 0
+}
+
+func (x *Episode) GetEpisodeNumber() int32 {
+	if x != nil {
+		return x.EpisodeNumber
+	}
+	return 0
+}
+
+func (x *Episode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Episode) GetOverview() string {
+	if x != nil {
+		return x.Overview
+	}
+	return ""
+}
+
+func (x *Episode) GetAirDate() string {
+	if x != nil {
+		return x.AirDate
+	}
+	return ""
+}
+
+func (x *Episode) GetRuntime() int32 {
+	if x != nil {
+		return x.Runtime
+	}
+	return 0
+}
+
+func (x *Episode) GetStillPath() string {
+	if x != nil {
+		return x.StillPath
+	}
+	return ""
+}
+
+func (x *Episode) GetVoteAverage() float64 {
+	if x != nil {
+		return x.VoteAverage
+	}
+	return 0
+}
+
+func (x *Episode) GetStill() *Image {
+	if x != nil {
+		return x.Still
+	}
+	return nil
+}
+
+// Запрос связанных тайтлов
+type GetRelationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TitleId   int64     `protobuf:"varint,1,opt,name=title_id,json=titleId,proto3" json:"title_id,omitempty"`
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Language  string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id        string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, как в GetMovieByIDRequest
+}
+
+func (x *GetRelationsRequest) Reset() {
+	*x = GetRelationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelationsRequest) ProtoMessage() {}
+
+func (x *GetRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRelationsRequest) GetTitleId() int64 {
+	if x != nil {
+		return x.TitleId
+	}
+	return 0
+}
+
+func (x *GetRelationsRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetRelationsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetRelationsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Связь с другим тайтлом
+type Relation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   RelationType `protobuf:"varint,1,opt,name=type,proto3,enum=metadata.RelationType" json:"type,omitempty"`
+	Title  *Movie       `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Format TitleFormat  `protobuf:"varint,3,opt,name=format,proto3,enum=metadata.TitleFormat" json:"format,omitempty"`
+}
+
+func (x *Relation) Reset() {
+	*x = Relation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relation) ProtoMessage() {}
+
+func (x *Relation) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relation.ProtoReflect.Descriptor instead.
+func (*Relation) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Relation) GetType() RelationType {
+	if x != nil {
+		return x.Type
+	}
+	return RelationType_RELATION_TYPE_UNSPECIFIED
+}
+
+func (x *Relation) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *Relation) GetFormat() TitleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TitleFormat_TITLE_FORMAT_UNSPECIFIED
+}
+
+// Позиция в порядке просмотра франшизы
+type WatchOrderEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title  *Movie      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Format TitleFormat `protobuf:"varint,2,opt,name=format,proto3,enum=metadata.TitleFormat" json:"format,omitempty"`
+	// Побочная история, рекап или фильм-пересказ: для понимания
+	// основного сюжета смотреть необязательно
+	Optional bool `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+}
+
+func (x *WatchOrderEntry) Reset() {
+	*x = WatchOrderEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchOrderEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderEntry) ProtoMessage() {}
+
+func (x *WatchOrderEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchOrderEntry.ProtoReflect.Descriptor instead.
+func (*WatchOrderEntry) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *WatchOrderEntry) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *WatchOrderEntry) GetFormat() TitleFormat {
+	if x != nil {
+		return x.Format
+	}
+	return TitleFormat_TITLE_FORMAT_UNSPECIFIED
+}
+
+func (x *WatchOrderEntry) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+// Связи тайтла и порядок просмотра всей франшизы
+type RelationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title      *Movie             `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Relations  []*Relation        `protobuf:"bytes,2,rep,name=relations,proto3" json:"relations,omitempty"` // Прямые связи запрошенного тайтла
+	WatchOrder []*WatchOrderEntry `protobuf:"bytes,3,rep,name=watch_order,json=watchOrder,proto3" json:"watch_order,omitempty"`
+}
+
+func (x *RelationsResponse) Reset() {
+	*x = RelationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelationsResponse) ProtoMessage() {}
+
+func (x *RelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelationsResponse.ProtoReflect.Descriptor instead.
+func (*RelationsResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RelationsResponse) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *RelationsResponse) GetRelations() []*Relation {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *RelationsResponse) GetWatchOrder() []*WatchOrderEntry {
+	if x != nil {
+		return x.WatchOrder
+	}
+	return nil
+}
+
+// Запрос состава тайтла
+type GetCreditsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TitleId   int64     `protobuf:"varint,1,opt,name=title_id,json=titleId,proto3" json:"title_id,omitempty"`
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Language  string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id        string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, как в GetMovieByIDRequest
+}
+
+func (x *GetCreditsRequest) Reset() {
+	*x = GetCreditsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCreditsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditsRequest) ProtoMessage() {}
+
+func (x *GetCreditsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditsRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditsRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetCreditsRequest) GetTitleId() int64 {
+	if x != nil {
+		return x.TitleId
+	}
+	return 0
+}
+
+func (x *GetCreditsRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetCreditsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetCreditsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Актёр (для аниме — сэйю) и его роль
+type CastMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Person       *PersonSummary `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`                                  // Без known_for
+	Character    string         `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`                            // Имя персонажа; у сериалов роли перечислены через " / "
+	Order        int32          `protobuf:"varint,3,opt,name=order,proto3" json:"order,omitempty"`                                   // Порядок в титрах, с 0
+	EpisodeCount int32          `protobuf:"varint,4,opt,name=episode_count,json=episodeCount,proto3" json:"episode_count,omitempty"` // Для сериалов: в скольких эпизодах участвует; 0 — неизвестно
+}
+
+func (x *CastMember) Reset() {
+	*x = CastMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastMember) ProtoMessage() {}
+
+func (x *CastMember) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastMember.ProtoReflect.Descriptor instead.
+func (*CastMember) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CastMember) GetPerson() *PersonSummary {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *CastMember) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *CastMember) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *CastMember) GetEpisodeCount() int32 {
+	if x != nil {
+		return x.EpisodeCount
+	}
+	return 0
+}
+
+// Участник съёмочной группы
+type CrewMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Person     *PersonSummary `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`         // Без known_for
+	Department string         `protobuf:"bytes,2,opt,name=department,proto3" json:"department,omitempty"` // Например, "Directing"; пусто — источник не указывает
+	Job        string         `protobuf:"bytes,3,opt,name=job,proto3" json:"job,omitempty"`               // Например, "Director"; у сериалов должности перечислены через " / "
+}
+
+func (x *CrewMember) Reset() {
+	*x = CrewMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrewMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrewMember) ProtoMessage() {}
+
+func (x *CrewMember) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrewMember.ProtoReflect.Descriptor instead.
+func (*CrewMember) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CrewMember) GetPerson() *PersonSummary {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *CrewMember) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *CrewMember) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+// Состав тайтла
+type CreditsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cast []*CastMember `protobuf:"bytes,1,rep,name=cast,proto3" json:"cast,omitempty"`
+	Crew []*CrewMember `protobuf:"bytes,2,rep,name=crew,proto3" json:"crew,omitempty"`
+}
+
+func (x *CreditsResponse) Reset() {
+	*x = CreditsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditsResponse) ProtoMessage() {}
+
+func (x *CreditsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditsResponse.ProtoReflect.Descriptor instead.
+func (*CreditsResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreditsResponse) GetCast() []*CastMember {
+	if x != nil {
+		return x.Cast
+	}
+	return nil
+}
+
+func (x *CreditsResponse) GetCrew() []*CrewMember {
+	if x != nil {
+		return x.Crew
+	}
+	return nil
+}
+
+// Запрос персонажей тайтла
+type GetCharactersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TitleId   int64     `protobuf:"varint,1,opt,name=title_id,json=titleId,proto3" json:"title_id,omitempty"`
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Language  string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id        string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, как в GetMovieByIDRequest
+	Page      int32     `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetCharactersRequest) Reset() {
+	*x = GetCharactersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCharactersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCharactersRequest) ProtoMessage() {}
+
+func (x *GetCharactersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCharactersRequest.ProtoReflect.Descriptor instead.
+func (*GetCharactersRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetCharactersRequest) GetTitleId() int64 {
+	if x != nil {
+		return x.TitleId
+	}
+	return 0
+}
+
+func (x *GetCharactersRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetCharactersRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetCharactersRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCharactersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+// Актёр озвучивания персонажа
+type VoiceActor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Person   *PersonSummary `protobuf:"bytes,1,opt,name=person,proto3" json:"person,omitempty"`     // Без known_for
+	Language string         `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"` // Код ISO 639-1 языка озвучки, например "ja"
+}
+
+func (x *VoiceActor) Reset() {
+	*x = VoiceActor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoiceActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoiceActor) ProtoMessage() {}
+
+func (x *VoiceActor) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoiceActor.ProtoReflect.Descriptor instead.
+func (*VoiceActor) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *VoiceActor) GetPerson() *PersonSummary {
+	if x != nil {
+		return x.Person
+	}
+	return nil
+}
+
+func (x *VoiceActor) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Персонаж тайтла
+type Character struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 0 — источник не ведёт карточки персонажей
+	Name       string        `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NativeName string        `protobuf:"bytes,3,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"` // Имя на языке оригинала
+	ImagePath  string        `protobuf:"bytes,4,opt,name=image_path,json=imagePath,proto3" json:"image_path,omitempty"`    // Устаревшее: используйте image
+	Role       CharacterRole `protobuf:"varint,5,opt,name=role,proto3,enum=metadata.CharacterRole" json:"role,omitempty"`
+	// Сначала японская озвучка, затем на языке запроса, затем остальные
+	VoiceActors []*VoiceActor `protobuf:"bytes,6,rep,name=voice_actors,json=voiceActors,proto3" json:"voice_actors,omitempty"`
+	Image       *Image        `protobuf:"bytes,7,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *Character) Reset() {
+	*x = Character{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Character) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Character) ProtoMessage() {}
+
+func (x *Character) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Character.ProtoReflect.Descriptor instead.
+func (*Character) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Character) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Character) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Character) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *Character) GetImagePath() string {
+	if x != nil {
+		return x.ImagePath
+	}
+	return ""
+}
+
+func (x *Character) GetRole() CharacterRole {
+	if x != nil {
+		return x.Role
+	}
+	return CharacterRole_CHARACTER_ROLE_UNSPECIFIED
+}
+
+func (x *Character) GetVoiceActors() []*VoiceActor {
+	if x != nil {
+		return x.VoiceActors
+	}
+	return nil
+}
+
+func (x *Character) GetImage() *Image {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// Страница списка персонажей, главные персонажи первыми
+type CharactersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*Character `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32        `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32        `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32        `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *CharactersResponse) Reset() {
+	*x = CharactersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CharactersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CharactersResponse) ProtoMessage() {}
+
+func (x *CharactersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CharactersResponse.ProtoReflect.Descriptor instead.
+func (*CharactersResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CharactersResponse) GetResults() []*Character {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *CharactersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CharactersResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *CharactersResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос карточки человека
+type GetPersonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PersonId int64  `protobuf:"varint,1,opt,name=person_id,json=personId,proto3" json:"person_id,omitempty"` // ID в источнике, выбранном для запроса
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *GetPersonRequest) Reset() {
+	*x = GetPersonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPersonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPersonRequest) ProtoMessage() {}
+
+func (x *GetPersonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPersonRequest.ProtoReflect.Descriptor instead.
+func (*GetPersonRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPersonRequest) GetPersonId() int64 {
+	if x != nil {
+		return x.PersonId
+	}
+	return 0
+}
+
+func (x *GetPersonRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Участие человека в тайтле
+type FilmographyCredit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title      *Movie `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Character  string `protobuf:"bytes,2,opt,name=character,proto3" json:"character,omitempty"`   // Для ролей: имя персонажа
+	Department string `protobuf:"bytes,3,opt,name=department,proto3" json:"department,omitempty"` // "Acting" для ролей
+	Job        string `protobuf:"bytes,4,opt,name=job,proto3" json:"job,omitempty"`               // Для съёмочной группы: должность
+}
+
+func (x *FilmographyCredit) Reset() {
+	*x = FilmographyCredit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilmographyCredit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilmographyCredit) ProtoMessage() {}
+
+func (x *FilmographyCredit) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilmographyCredit.ProtoReflect.Descriptor instead.
+func (*FilmographyCredit) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *FilmographyCredit) GetTitle() *Movie {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *FilmographyCredit) GetCharacter() string {
+	if x != nil {
+		return x.Character
+	}
+	return ""
+}
+
+func (x *FilmographyCredit) GetDepartment() string {
+	if x != nil {
+		return x.Department
+	}
+	return ""
+}
+
+func (x *FilmographyCredit) GetJob() string {
+	if x != nil {
+		return x.Job
+	}
+	return ""
+}
+
+// Подробная карточка человека
+type PersonDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                 int64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	NativeName         string               `protobuf:"bytes,3,opt,name=native_name,json=nativeName,proto3" json:"native_name,omitempty"` // Имя на родном языке, если источник его знает
+	Biography          string               `protobuf:"bytes,4,opt,name=biography,proto3" json:"biography,omitempty"`
+	Birthday           string               `protobuf:"bytes,5,opt,name=birthday,proto3" json:"birthday,omitempty"` // YYYY-MM-DD
+	Deathday           string               `protobuf:"bytes,6,opt,name=deathday,proto3" json:"deathday,omitempty"`
+	PlaceOfBirth       string               `protobuf:"bytes,7,opt,name=place_of_birth,json=placeOfBirth,proto3" json:"place_of_birth,omitempty"`
+	ProfilePath        string               `protobuf:"bytes,8,opt,name=profile_path,json=profilePath,proto3" json:"profile_path,omitempty"` // Устаревшее: используйте profile
+	KnownForDepartment string               `protobuf:"bytes,9,opt,name=known_for_department,json=knownForDepartment,proto3" json:"known_for_department,omitempty"`
+	Popularity         float64              `protobuf:"fixed64,10,opt,name=popularity,proto3" json:"popularity,omitempty"`
+	Filmography        []*FilmographyCredit `protobuf:"bytes,11,rep,name=filmography,proto3" json:"filmography,omitempty"` // Новые тайтлы первыми
+	Profile            *Image               `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *PersonDetails) Reset() {
+	*x = PersonDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonDetails) ProtoMessage() {}
+
+func (x *PersonDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonDetails.ProtoReflect.Descriptor instead.
+func (*PersonDetails) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PersonDetails) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PersonDetails) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetNativeName() string {
+	if x != nil {
+		return x.NativeName
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetBiography() string {
+	if x != nil {
+		return x.Biography
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetDeathday() string {
+	if x != nil {
+		return x.Deathday
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetPlaceOfBirth() string {
+	if x != nil {
+		return x.PlaceOfBirth
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetProfilePath() string {
+	if x != nil {
+		return x.ProfilePath
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetKnownForDepartment() string {
+	if x != nil {
+		return x.KnownForDepartment
+	}
+	return ""
+}
+
+func (x *PersonDetails) GetPopularity() float64 {
+	if x != nil {
+		return x.Popularity
+	}
+	return 0
+}
+
+func (x *PersonDetails) GetFilmography() []*FilmographyCredit {
+	if x != nil {
+		return x.Filmography
+	}
+	return nil
+}
+
+func (x *PersonDetails) GetProfile() *Image {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Запрос рекомендаций или похожих тайтлов
+type GetRecommendationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TitleId   int64     `protobuf:"varint,1,opt,name=title_id,json=titleId,proto3" json:"title_id,omitempty"`
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Language  string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id        string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, как в GetMovieByIDRequest
+	Page      int32     `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+}
+
+func (x *GetRecommendationsRequest) Reset() {
+	*x = GetRecommendationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendationsRequest) ProtoMessage() {}
+
+func (x *GetRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRecommendationsRequest) GetTitleId() int64 {
+	if x != nil {
+		return x.TitleId
+	}
+	return 0
+}
+
+func (x *GetRecommendationsRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetRecommendationsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRecommendationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+// Страница рекомендаций или похожих тайтлов
+type RecommendationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*Movie `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Page         int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	TotalPages   int32    `protobuf:"varint,3,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	TotalResults int32    `protobuf:"varint,4,opt,name=total_results,json=totalResults,proto3" json:"total_results,omitempty"`
+}
+
+func (x *RecommendationsResponse) Reset() {
+	*x = RecommendationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendationsResponse) ProtoMessage() {}
+
+func (x *RecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*RecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *RecommendationsResponse) GetResults() []*Movie {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *RecommendationsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RecommendationsResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+func (x *RecommendationsResponse) GetTotalResults() int32 {
+	if x != nil {
+		return x.TotalResults
+	}
+	return 0
+}
+
+// Запрос видео тайтла
+type GetVideosRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TitleId   int64     `protobuf:"varint,1,opt,name=title_id,json=titleId,proto3" json:"title_id,omitempty"`
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"` // Фильм или сериал
+	Language  string    `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Id        string    `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, как в GetMovieByIDRequest
+}
+
+func (x *GetVideosRequest) Reset() {
+	*x = GetVideosRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideosRequest) ProtoMessage() {}
+
+func (x *GetVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideosRequest.ProtoReflect.Descriptor instead.
+func (*GetVideosRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetVideosRequest) GetTitleId() int64 {
+	if x != nil {
+		return x.TitleId
+	}
+	return 0
+}
+
+func (x *GetVideosRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *GetVideosRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *GetVideosRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Видео на внешнем хостинге
+type Video struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type        VideoType `protobuf:"varint,3,opt,name=type,proto3,enum=metadata.VideoType" json:"type,omitempty"`
+	Site        string    `protobuf:"bytes,4,opt,name=site,proto3" json:"site,omitempty"`                                  // Хостинг, например "YouTube"
+	Key         string    `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`                                    // ID видео на хостинге
+	Url         string    `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`                                    // Ссылка на просмотр; пусто — хостинг неизвестен
+	Language    string    `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`                          // Код ISO 639-1; пусто — источник не указывает
+	Official    bool      `protobuf:"varint,8,opt,name=official,proto3" json:"official,omitempty"`                         // Опубликовано правообладателем
+	PublishedAt string    `protobuf:"bytes,9,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"` // RFC 3339
+}
+
+func (x *Video) Reset() {
+	*x = Video{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Video) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Video) ProtoMessage() {}
+
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Video) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Video) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Video) GetType() VideoType {
+	if x != nil {
+		return x.Type
+	}
+	return VideoType_VIDEO_TYPE_UNSPECIFIED
+}
+
+func (x *Video) GetSite() string {
+	if x != nil {
+		return x.Site
+	}
+	return ""
+}
+
+func (x *Video) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Video) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Video) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Video) GetOfficial() bool {
+	if x != nil {
+		return x.Official
+	}
+	return false
+}
+
+func (x *Video) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+// Видео тайтла: официальные трейлеры первыми
+type VideosResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*Video `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Язык, на котором нашлись видео. Отличается от запрошенного, если
+	// на нём видео не нашлось
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+}
+
+func (x *VideosResponse) Reset() {
+	*x = VideosResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VideosResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideosResponse) ProtoMessage() {}
+
+func (x *VideosResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideosResponse.ProtoReflect.Descriptor instead.
+func (*VideosResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *VideosResponse) GetResults() []*Video {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *VideosResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Запрос на поиск ID тайтла в других источниках
+type ResolveIDsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // ID с пространством имён, например "anilist:21"
+	// Тип тайтла; нужен для ID TMDb, у которого фильмы и сериалы
+	// пронумерованы независимо. Не задан — сначала ищется фильм.
+	MediaType MediaType `protobuf:"varint,2,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"`
+}
+
+func (x *ResolveIDsRequest) Reset() {
+	*x = ResolveIDsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIDsRequest) ProtoMessage() {}
+
+func (x *ResolveIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIDsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveIDsRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ResolveIDsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ResolveIDsRequest) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+// Известные ID тайтла
+type ResolveIDsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MediaType MediaType         `protobuf:"varint,1,opt,name=media_type,json=mediaType,proto3,enum=metadata.MediaType" json:"media_type,omitempty"`
+	Ids       map[string]string `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // Пространство имён → ID
+}
+
+func (x *ResolveIDsResponse) Reset() {
+	*x = ResolveIDsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResolveIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveIDsResponse) ProtoMessage() {}
+
+func (x *ResolveIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveIDsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveIDsResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ResolveIDsResponse) GetMediaType() MediaType {
+	if x != nil {
+		return x.MediaType
+	}
+	return MediaType_MEDIA_TYPE_UNSPECIFIED
+}
+
+func (x *ResolveIDsResponse) GetIds() map[string]string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// Запрос статистики сервиса
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{53}
+}
+
+// Статистика сервиса
+type StatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cache    *CacheStats    `protobuf:"bytes,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Upstream *UpstreamStats `protobuf:"bytes,2,opt,name=upstream,proto3" json:"upstream,omitempty"`
+}
+
+func (x *StatsResponse) Reset() {
+	*x = StatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsResponse) ProtoMessage() {}
+
+func (x *StatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
+func (*StatsResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StatsResponse) GetCache() *CacheStats {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *StatsResponse) GetUpstream() *UpstreamStats {
+	if x != nil {
+		return x.Upstream
+	}
+	return nil
+}
+
+// Счётчики кэша ответов в памяти
+type CacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hits      uint64 `protobuf:"varint,1,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses    uint64 `protobuf:"varint,2,opt,name=misses,proto3" json:"misses,omitempty"`
+	Evictions uint64 `protobuf:"varint,3,opt,name=evictions,proto3" json:"evictions,omitempty"` // Вытеснено по лимиту размера
+	Expired   uint64 `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`     // Удалено по истечении TTL
+	Entries   int64  `protobuf:"varint,5,opt,name=entries,proto3" json:"entries,omitempty"`
+	Bytes     int64  `protobuf:"varint,6,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CacheStats) GetHits() uint64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() uint64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetEvictions() uint64 {
+	if x != nil {
+		return x.Evictions
+	}
+	return 0
+}
+
+func (x *CacheStats) GetExpired() uint64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *CacheStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *CacheStats) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+// Счётчики обращений к внешнему API источника метаданных
+type UpstreamStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider  string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Calls     uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`         // Сколько раз понадобились данные из API
+	Requests  uint64 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`   // Сколько HTTP-запросов реально отправлено
+	Coalesced uint64 `protobuf:"varint,4,opt,name=coalesced,proto3" json:"coalesced,omitempty"` // Сколько вызовов дождались чужого одинакового запроса
+}
+
+func (x *UpstreamStats) Reset() {
+	*x = UpstreamStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpstreamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpstreamStats) ProtoMessage() {}
+
+func (x *UpstreamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpstreamStats.ProtoReflect.Descriptor instead.
+func (*UpstreamStats) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpstreamStats) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *UpstreamStats) GetCalls() uint64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *UpstreamStats) GetRequests() uint64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *UpstreamStats) GetCoalesced() uint64 {
+	if x != nil {
+		return x.Coalesced
+	}
+	return 0
+}
+
+// Запрос на очистку кэша
+type PurgeCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rpc string `protobuf:"bytes,1,opt,name=rpc,proto3" json:"rpc,omitempty"` // Имя метода (например, "SearchMovies"); пусто — весь кэш
+}
+
+func (x *PurgeCacheRequest) Reset() {
+	*x = PurgeCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCacheRequest) ProtoMessage() {}
+
+func (x *PurgeCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCacheRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCacheRequest) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PurgeCacheRequest) GetRpc() string {
+	if x != nil {
+		return x.Rpc
+	}
+	return ""
+}
+
+// Результат очистки кэша
+type PurgeCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Purged int32 `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"` // Количество удалённых записей
+}
+
+func (x *PurgeCacheResponse) Reset() {
+	*x = PurgeCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_metadata_proto_metadata_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCacheResponse) ProtoMessage() {}
+
+func (x *PurgeCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_metadata_proto_metadata_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCacheResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCacheResponse) Descriptor() ([]byte, []int) {
+	return file_metadata_proto_metadata_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *PurgeCacheResponse) GetPurged() int32 {
+	if x != nil {
+		return x.Purged
+	}
+	return 0
+}
+
+var File_metadata_proto_metadata_proto protoreflect.FileDescriptor
+
+var file_metadata_proto_metadata_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x49, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c,
+	0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76,
+	0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x22, 0x5c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x6f, 0x76, 0x69, 0x65,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55,
+	0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x70, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x22, 0xb0, 0x03, 0x0a, 0x05, 0x4d, 0x6f, 0x76, 0x69,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69,
+	0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c,
+	0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2b,
+	0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x61,
+	0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x22, 0xaa, 0x02, 0x0a, 0x05, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x2d, 0x0a, 0x04, 0x75, 0x72, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x2e, 0x55, 0x72, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x75, 0x72, 0x6c, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x72, 0x63, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x72, 0x63, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x6c, 0x75, 0x72,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6c, 0x75, 0x72,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x61,
+	0x63, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x1a, 0x37,
+	0x0a, 0x09, 0x55, 0x72, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x2b, 0x0a, 0x05, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0xf0, 0x09, 0x0a, 0x0c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x61, 0x67, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a,
+	0x0d, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x6f, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x6f, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73,
+	0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x0a,
+	0x06, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x52, 0x06,
+	0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x64, 0x75, 0x6c, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f,
+	0x74, 0x65, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x69, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x13, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x41, 0x69, 0x72, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x4f, 0x66, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x5f, 0x6f, 0x66, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x4f, 0x66, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x07, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49,
+	0x64, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x65, 0x70, 0x69, 0x73, 0x6f,
+	0x64, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x41, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x5c,
+	0x0a, 0x12, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x2e, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x11, 0x66, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x06,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x06, 0x70,
+	0x6f, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72, 0x6f,
+	0x70, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x08, 0x62, 0x61, 0x63, 0x6b, 0x64, 0x72,
+	0x6f, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x1c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11,
+	0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x73, 0x1a, 0x3e, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x44, 0x0a, 0x16, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x13, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x09,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x25, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x81, 0x02, 0x0a, 0x0d,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x6f,
+	0x72, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x6f, 0x72, 0x44, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x70, 0x6f, 0x70, 0x75,
+	0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x09, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f,
+	0x66, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x08, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x46, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22,
+	0x7c, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x6e, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22, 0xa8, 0x01,
+	0x0a, 0x14, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0x98, 0x01, 0x0a, 0x11, 0x41, 0x6e, 0x69,
+	0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0xcc, 0x03, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x73,
+	0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x69, 0x74,
+	0x68, 0x5f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a,
+	0x77, 0x69, 0x74, 0x68, 0x47, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x5f, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x47, 0x65, 0x6e, 0x72, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x79, 0x65, 0x61, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17,
+	0x0a, 0x07, 0x79, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x79, 0x65, 0x61, 0x72, 0x54, 0x6f, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e,
+	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x56, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x4c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x35, 0x0a, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x97,
+	0x01, 0x0a, 0x10, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61,
+	0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52,
+	0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x07, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74,
+	0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65,
+	0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x61, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61,
+	0x6c, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x6e,
+	0x69, 0x6d, 0x65, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x73, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68,
+	0x61, 0x72, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x0d, 0x41, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0c, 0x61, 0x69, 0x72, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x69, 0x72, 0x73, 0x41, 0x74, 0x55,
+	0x6e, 0x69, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x69, 0x72, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x69, 0x72, 0x73, 0x41, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x41, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67,
+	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x73, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x54, 0x56,
+	0x53, 0x68, 0x6f, 0x77, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x13, 0x0a, 0x05, 0x74, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x74, 0x76, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x13, 0x0a, 0x05, 0x74, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x74, 0x76, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x13, 0x0a, 0x05,
+	0x74, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x76, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64,
+	0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d,
+	0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xbb, 0x02, 0x0a, 0x06, 0x53, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x76, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72, 0x76, 0x69, 0x65, 0x77, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x69, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6f, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2d, 0x0a, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x70, 0x69,
+	0x73, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x27,
+	0x0a, 0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x70, 0x6f, 0x73, 0x74, 0x65, 0x72, 0x22, 0xc8, 0x02, 0x0a, 0x07, 0x45, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x76, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x76, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x76, 0x65, 0x72,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x69, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x69, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x69,
+	0x6c, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x69, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65,
+	0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x76, 0x6f, 0x74, 0x65, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x25, 0x0a, 0x05, 0x73,
+	0x74, 0x69, 0x6c, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x05, 0x73, 0x74, 0x69,
+	0x6c, 0x6c, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x2d, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xa8, 0x01, 0x0a, 0x11, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61,
+	0x63, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x70,
+	0x69, 0x73, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x65, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x6f, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6a, 0x6f, 0x62,
+	0x22, 0x65, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x61, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x63, 0x61, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x04, 0x63, 0x72, 0x65, 0x77, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x77, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x52, 0x04, 0x63, 0x72, 0x65, 0x77, 0x22, 0xa5, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x22,
+	0x59, 0x0a, 0x0a, 0x56, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2f, 0x0a,
+	0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x53,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x06, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x09, 0x43,
+	0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2b, 0x0a, 0x04,
+	0x72, 0x6f, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x6f, 0x69, 0x63, 0x65,
+	0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x12, 0x43, 0x68,
+	0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x68, 0x61,
+	0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
+	0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x6d, 0x6f,
+	0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x6f, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6a, 0x6f, 0x62, 0x22, 0xaf, 0x03, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x69,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x69, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x12, 0x24, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x6f, 0x66, 0x5f, 0x62, 0x69, 0x72,
+	0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x4f,
+	0x66, 0x42, 0x69, 0x72, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x30, 0x0a, 0x14, 0x6b, 0x6e, 0x6f,
+	0x77, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x6f,
+	0x72, 0x44, 0x65, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x70, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x0b, 0x66,
+	0x69, 0x6c, 0x6d, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x46, 0x69, 0x6c, 0x6d,
+	0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x0b, 0x66,
+	0x69, 0x6c, 0x6d, 0x6f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x79, 0x12, 0x29, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x32,
+	0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
+	0x67, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x69, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x6f, 0x66, 0x66, 0x69, 0x63, 0x69, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57, 0x0a,
+	0x0e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x57, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76,
+	0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x22,
+	0xb9, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x09, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x03, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x1a, 0x36, 0x0a, 0x08, 0x49, 0x64, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x11, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x70,
+	0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2a, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x22, 0xa0, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68,
+	0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09,
+	0x65, 0x76, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x0d, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x61, 0x6c, 0x65, 0x73, 0x63, 0x65, 0x64,
+	0x22, 0x25, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x72, 0x70, 0x63, 0x22, 0x2c, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70,
+	0x75, 0x72, 0x67, 0x65, 0x64, 0x2a, 0x67, 0x0a, 0x09, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x56,
+	0x49, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x54, 0x56, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x44, 0x49, 0x41,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x85,
+	0x01, 0x0a, 0x09, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x44, 0x52, 0x4f, 0x50, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x41, 0x47,
+	0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x03,
+	0x12, 0x14, 0x0a, 0x10, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x53,
+	0x54, 0x49, 0x4c, 0x4c, 0x10, 0x04, 0x2a, 0xc6, 0x01, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x1e, 0x0a, 0x1a, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x50,
+	0x4f, 0x50, 0x55, 0x4c, 0x41, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x01,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x56,
+	0x4f, 0x54, 0x45, 0x5f, 0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x43,
+	0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x45,
+	0x53, 0x43, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x52, 0x45, 0x4c, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x41, 0x53, 0x43, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52,
+	0x44, 0x45, 0x52, 0x5f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x05, 0x2a,
+	0x54, 0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x1b, 0x0a,
+	0x17, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49,
+	0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12,
+	0x14, 0x0a, 0x10, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x57,
+	0x45, 0x45, 0x4b, 0x10, 0x02, 0x2a, 0x8d, 0x01, 0x0a, 0x0b, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x4e, 0x49, 0x4d, 0x45, 0x5f, 0x53,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4e, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x41,
+	0x53, 0x4f, 0x4e, 0x5f, 0x57, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x41, 0x4e, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x50, 0x52,
+	0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x41, 0x4e, 0x49, 0x4d, 0x45, 0x5f, 0x53,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x45, 0x52, 0x10, 0x03, 0x12, 0x15,
+	0x0a, 0x11, 0x41, 0x4e, 0x49, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x46,
+	0x41, 0x4c, 0x4c, 0x10, 0x04, 0x2a, 0xd1, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x18, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x54, 0x56, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x49, 0x54, 0x4c,
+	0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x56, 0x5f, 0x53, 0x48, 0x4f, 0x52,
+	0x54, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x4d, 0x4f, 0x56, 0x49, 0x45, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x54,
+	0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x56, 0x41, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4f, 0x4e, 0x41, 0x10,
+	0x06, 0x12, 0x16, 0x0a, 0x12, 0x54, 0x49, 0x54, 0x4c, 0x45, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4d, 0x55, 0x53, 0x49, 0x43, 0x10, 0x07, 0x2a, 0xbb, 0x01, 0x0a, 0x0c, 0x41, 0x69,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x49,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x49, 0x52,
+	0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x59,
+	0x45, 0x54, 0x5f, 0x41, 0x49, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x49,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x49, 0x52, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1b, 0x0a, 0x17, 0x41, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x48,
+	0x49, 0x41, 0x54, 0x55, 0x53, 0x10, 0x05, 0x2a, 0xca, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4c, 0x10,
+	0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x50, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14,
+	0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x41,
+	0x52, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x4f,
+	0x52, 0x59, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x49, 0x4e, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x05,
+	0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4d, 0x4f, 0x56, 0x49, 0x45, 0x5f, 0x41, 0x44, 0x41, 0x50, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x4d, 0x41, 0x52, 0x59, 0x10, 0x07, 0x12,
+	0x1d, 0x0a, 0x19, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x41, 0x4c, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x08, 0x12, 0x1b,
+	0x0a, 0x17, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x10, 0x09, 0x12, 0x17, 0x0a, 0x13, 0x52,
+	0x45, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0x0a, 0x2a, 0x86, 0x01, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43,
+	0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43,
+	0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x01, 0x12,
+	0x1d, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c,
+	0x45, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x43, 0x48, 0x41, 0x52, 0x41, 0x43, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x42, 0x41, 0x43, 0x4b, 0x47, 0x52, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x03, 0x2a, 0xd9, 0x01,
+	0x0a, 0x09, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x56,
+	0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x44, 0x45, 0x4f,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x49, 0x4c, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45,
+	0x41, 0x53, 0x45, 0x52, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x13,
+	0x0a, 0x0f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4c, 0x49,
+	0x50, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x54, 0x54, 0x45, 0x10, 0x05, 0x12, 0x20,
+	0x0a, 0x1c, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x45, 0x48,
+	0x49, 0x4e, 0x44, 0x5f, 0x54, 0x48, 0x45, 0x5f, 0x53, 0x43, 0x45, 0x4e, 0x45, 0x53, 0x10, 0x06,
+	0x12, 0x17, 0x0a, 0x13, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42,
+	0x4c, 0x4f, 0x4f, 0x50, 0x45, 0x52, 0x53, 0x10, 0x07, 0x32, 0x93, 0x0e, 0x0a, 0x0f, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x59, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65,
+	0x73, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d,
+	0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x42, 0x79, 0x49, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x41, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x6f, 0x76, 0x69, 0x65, 0x73, 0x12,
+	0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x54, 0x56, 0x53, 0x68,
+	0x6f, 0x77, 0x73, 0x12, 0x17, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72,
+	0x41, 0x6e, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x70, 0x75, 0x6c, 0x61, 0x72, 0x41, 0x6e, 0x69, 0x6d, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72,
+	0x41, 0x6e, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x41, 0x6e, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x19,
+	0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61,
+	0x72, 0x74, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x2e, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x69, 0x72, 0x69, 0x6e, 0x67,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x41, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x47, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x56, 0x53, 0x68, 0x6f, 0x77, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x56, 0x53, 0x68, 0x6f, 0x77, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x45, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x45, 0x70, 0x69, 0x73, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x45, 0x70, 0x69, 0x73,
+	0x6f, 0x64, 0x65, 0x12, 0x4a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x1b, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72,
+	0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x43, 0x68, 0x61, 0x72, 0x61, 0x63, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x73, 0x6f,
+	0x6e, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x69, 0x6d, 0x69, 0x6c,
+	0x61, 0x72, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x44, 0x73, 0x12, 0x1b, 0x2e, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x44,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x49, 0x44, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x19, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x1b, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
+	0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f,
+	0x75, 0x72, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x68, 0x69, 0x6b, 0x61, 0x72,
+	0x69, 0x2f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_metadata_proto_metadata_proto_rawDescOnce sync.Once
+	file_metadata_proto_metadata_proto_rawDescData = file_metadata_proto_metadata_proto_rawDesc
+)
+
+func file_metadata_proto_metadata_proto_rawDescGZIP() []byte {
+	file_metadata_proto_metadata_proto_rawDescOnce.Do(func() {
+		file_metadata_proto_metadata_proto_rawDescData = protoimpl.X.CompressGZIP(file_metadata_proto_metadata_proto_rawDescData)
+	})
+	return file_metadata_proto_metadata_proto_rawDescData
+}
+
+var file_metadata_proto_metadata_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_metadata_proto_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_metadata_proto_metadata_proto_goTypes = []interface{}{
+	(MediaType)(0),                    // 0: metadata.MediaType
+	(ImageKind)(0),                    // 1: metadata.ImageKind
+	(SortOrder)(0),                    // 2: metadata.SortOrder
+	(TimeWindow)(0),                   // 3: metadata.TimeWindow
+	(AnimeSeason)(0),                  // 4: metadata.AnimeSeason
+	(TitleFormat)(0),                  // 5: metadata.TitleFormat
+	(AiringStatus)(0),                 // 6: metadata.AiringStatus
+	(RelationType)(0),                 // 7: metadata.RelationType
+	(CharacterRole)(0),                // 8: metadata.CharacterRole
+	(VideoType)(0),                    // 9: metadata.VideoType
+	(*GetPopularMoviesRequest)(nil),   // 10: metadata.GetPopularMoviesRequest
+	(*GetPopularMoviesResponse)(nil),  // 11: metadata.GetPopularMoviesResponse
+	(*GetMovieByIDRequest)(nil),       // 12: metadata.GetMovieByIDRequest
+	(*SearchRequest)(nil),             // 13: metadata.SearchRequest
+	(*SearchResponse)(nil),            // 14: metadata.SearchResponse
+	(*Movie)(nil),                     // 15: metadata.Movie
+	(*Image)(nil),                     // 16: metadata.Image
+	(*Genre)(nil),                     // 17: metadata.Genre
+	(*TitleDetails)(nil),              // 18: metadata.TitleDetails
+	(*MultiSearchRequest)(nil),        // 19: metadata.MultiSearchRequest
+	(*MultiSearchResponse)(nil),       // 20: metadata.MultiSearchResponse
+	(*SearchHit)(nil),                 // 21: metadata.SearchHit
+	(*PersonSummary)(nil),             // 22: metadata.PersonSummary
+	(*GetPopularAnimeRequest)(nil),    // 23: metadata.GetPopularAnimeRequest
+	(*DiscoverAnimeRequest)(nil),      // 24: metadata.DiscoverAnimeRequest
+	(*AnimeListResponse)(nil),         // 25: metadata.AnimeListResponse
+	(*DiscoverRequest)(nil),           // 26: metadata.DiscoverRequest
+	(*DiscoverResponse)(nil),          // 27: metadata.DiscoverResponse
+	(*GetTrendingRequest)(nil),        // 28: metadata.GetTrendingRequest
+	(*TrendingResponse)(nil),          // 29: metadata.TrendingResponse
+	(*GetSeasonalChartRequest)(nil),   // 30: metadata.GetSeasonalChartRequest
+	(*ChartEntry)(nil),                // 31: metadata.ChartEntry
+	(*SeasonalChartResponse)(nil),     // 32: metadata.SeasonalChartResponse
+	(*GetAiringScheduleRequest)(nil),  // 33: metadata.GetAiringScheduleRequest
+	(*AiringEpisode)(nil),             // 34: metadata.AiringEpisode
+	(*AiringScheduleResponse)(nil),    // 35: metadata.AiringScheduleResponse
+	(*GetTVShowByIDRequest)(nil),      // 36: metadata.GetTVShowByIDRequest
+	(*GetSeasonRequest)(nil),          // 37: metadata.GetSeasonRequest
+	(*GetEpisodeRequest)(nil),         // 38: metadata.GetEpisodeRequest
+	(*Season)(nil),                    // 39: metadata.Season
+	(*Episode)(nil),                   // 40: metadata.Episode
+	(*GetRelationsRequest)(nil),       // 41: metadata.GetRelationsRequest
+	(*Relation)(nil),                  // 42: metadata.Relation
+	(*WatchOrderEntry)(nil),           // 43: metadata.WatchOrderEntry
+	(*RelationsResponse)(nil),         // 44: metadata.RelationsResponse
+	(*GetCreditsRequest)(nil),         // 45: metadata.GetCreditsRequest
+	(*CastMember)(nil),                // 46: metadata.CastMember
+	(*CrewMember)(nil),                // 47: metadata.CrewMember
+	(*CreditsResponse)(nil),           // 48: metadata.CreditsResponse
+	(*GetCharactersRequest)(nil),      // 49: metadata.GetCharactersRequest
+	(*VoiceActor)(nil),                // 50: metadata.VoiceActor
+	(*Character)(nil),                 // 51: metadata.Character
+	(*CharactersResponse)(nil),        // 52: metadata.CharactersResponse
+	(*GetPersonRequest)(nil),          // 53: metadata.GetPersonRequest
+	(*FilmographyCredit)(nil),         // 54: metadata.FilmographyCredit
+	(*PersonDetails)(nil),             // 55: metadata.PersonDetails
+	(*GetRecommendationsRequest)(nil), // 56: metadata.GetRecommendationsRequest
+	(*RecommendationsResponse)(nil),   // 57: metadata.RecommendationsResponse
+	(*GetVideosRequest)(nil),          // 58: metadata.GetVideosRequest
+	(*Video)(nil),                     // 59: metadata.Video
+	(*VideosResponse)(nil),            // 60: metadata.VideosResponse
+	(*ResolveIDsRequest)(nil),         // 61: metadata.ResolveIDsRequest
+	(*ResolveIDsResponse)(nil),        // 62: metadata.ResolveIDsResponse
+	(*GetStatsRequest)(nil),           // 63: metadata.GetStatsRequest
+	(*StatsResponse)(nil),             // 64: metadata.StatsResponse
+	(*CacheStats)(nil),                // 65: metadata.CacheStats
+	(*UpstreamStats)(nil),             // 66: metadata.UpstreamStats
+	(*PurgeCacheRequest)(nil),         // 67: metadata.PurgeCacheRequest
+	(*PurgeCacheResponse)(nil),        // 68: metadata.PurgeCacheResponse
+	nil,                               // 69: metadata.Image.UrlsEntry
+	nil,                               // 70: metadata.TitleDetails.ExternalIdsEntry
+	nil,                               // 71: metadata.TitleDetails.FallbackLanguagesEntry
+	nil,                               // 72: metadata.ResolveIDsResponse.IdsEntry
+}
+var file_metadata_proto_metadata_proto_depIdxs = []int32{
+	15,  // 0: metadata.GetPopularMoviesResponse.results:type_name -> metadata.Movie
+	15,  // 1: metadata.SearchResponse.results:type_name -> metadata.Movie
+	0,   // 2: metadata.Movie.media_type:type_name -> metadata.MediaType
+	16,  // 3: metadata.Movie.poster:type_name -> metadata.Image
+	16,  // 4: metadata.Movie.backdrop:type_name -> metadata.Image
+	1,   // 5: metadata.Image.kind:type_name -> metadata.ImageKind
+	69,  // 6: metadata.Image.urls:type_name -> metadata.Image.UrlsEntry
+	0,   // 7: metadata.TitleDetails.media_type:type_name -> metadata.MediaType
+	17,  // 8: metadata.TitleDetails.genres:type_name -> metadata.Genre
+	39,  // 9: metadata.TitleDetails.seasons:type_name -> metadata.Season
+	70,  // 10: metadata.TitleDetails.external_ids:type_name -> metadata.TitleDetails.ExternalIdsEntry
+	34,  // 11: metadata.TitleDetails.next_episode:type_name -> metadata.AiringEpisode
+	71,  // 12: metadata.TitleDetails.fallback_languages:type_name -> metadata.TitleDetails.FallbackLanguagesEntry
+	16,  // 13: metadata.TitleDetails.poster:type_name -> metadata.Image
+	16,  // 14: metadata.TitleDetails.backdrop:type_name -> metadata.Image
+	0,   // 15: metadata.MultiSearchRequest.media_types:type_name -> metadata.MediaType
+	21,  // 16: metadata.MultiSearchResponse.results:type_name -> metadata.SearchHit
+	0,   // 17: metadata.SearchHit.media_type:type_name -> metadata.MediaType
+	15,  // 18: metadata.SearchHit.title:type_name -> metadata.Movie
+	22,  // 19: metadata.SearchHit.person:type_name -> metadata.PersonSummary
+	15,  // 20: metadata.PersonSummary.known_for:type_name -> metadata.Movie
+	16,  // 21: metadata.PersonSummary.profile:type_name -> metadata.Image
+	0,   // 22: metadata.GetPopularAnimeRequest.media_type:type_name -> metadata.MediaType
+	0,   // 23: metadata.DiscoverAnimeRequest.media_type:type_name -> metadata.MediaType
+	2,   // 24: metadata.DiscoverAnimeRequest.sort_by:type_name -> metadata.SortOrder
+	15,  // 25: metadata.AnimeListResponse.results:type_name -> metadata.Movie
+	0,   // 26: metadata.DiscoverRequest.media_type:type_name -> metadata.MediaType
+	2,   // 27: metadata.DiscoverRequest.sort_by:type_name -> metadata.SortOrder
+	15,  // 28: metadata.DiscoverResponse.results:type_name -> metadata.Movie
+	0,   // 29: metadata.GetTrendingRequest.media_type:type_name -> metadata.MediaType
+	3,   // 30: metadata.GetTrendingRequest.time_window:type_name -> metadata.TimeWindow
+	15,  // 31: metadata.TrendingResponse.results:type_name -> metadata.Movie
+	4,   // 32: metadata.GetSeasonalChartRequest.season:type_name -> metadata.AnimeSeason
+	5,   // 33: metadata.GetSeasonalChartRequest.formats:type_name -> metadata.TitleFormat
+	2,   // 34: metadata.GetSeasonalChartRequest.sort_by:type_name -> metadata.SortOrder
+	15,  // 35: metadata.ChartEntry.title:type_name -> metadata.Movie
+	5,   // 36: metadata.ChartEntry.format:type_name -> metadata.TitleFormat
+	6,   // 37: metadata.ChartEntry.airing_status:type_name -> metadata.AiringStatus
+	4,   // 38: metadata.SeasonalChartResponse.season:type_name -> metadata.AnimeSeason
+	31,  // 39: metadata.SeasonalChartResponse.results:type_name -> metadata.ChartEntry
+	15,  // 40: metadata.AiringEpisode.title:type_name -> metadata.Movie
+	34,  // 41: metadata.AiringScheduleResponse.results:type_name -> metadata.AiringEpisode
+	40,  // 42: metadata.Season.episodes:type_name -> metadata.Episode
+	16,  // 43: metadata.Season.poster:type_name -> metadata.Image
+	16,  // 44: metadata.Episode.still:type_name -> metadata.Image
+	0,   // 45: metadata.GetRelationsRequest.media_type:type_name -> metadata.MediaType
+	7,   // 46: metadata.Relation.type:type_name -> metadata.RelationType
+	15,  // 47: metadata.Relation.title:type_name -> metadata.Movie
+	5,   // 48: metadata.Relation.format:type_name -> metadata.TitleFormat
+	15,  // 49: metadata.WatchOrderEntry.title:type_name -> metadata.Movie
+	5,   // 50: metadata.WatchOrderEntry.format:type_name -> metadata.TitleFormat
+	15,  // 51: metadata.RelationsResponse.title:type_name -> metadata.Movie
+	42,  // 52: metadata.RelationsResponse.relations:type_name -> metadata.Relation
+	43,  // 53: metadata.RelationsResponse.watch_order:type_name -> metadata.WatchOrderEntry
+	0,   // 54: metadata.GetCreditsRequest.media_type:type_name -> metadata.MediaType
+	22,  // 55: metadata.CastMember.person:type_name -> metadata.PersonSummary
+	22,  // 56: metadata.CrewMember.person:type_name -> metadata.PersonSummary
+	46,  // 57: metadata.CreditsResponse.cast:type_name -> metadata.CastMember
+	47,  // 58: metadata.CreditsResponse.crew:type_name -> metadata.CrewMember
+	0,   // 59: metadata.GetCharactersRequest.media_type:type_name -> metadata.MediaType
+	22,  // 60: metadata.VoiceActor.person:type_name -> metadata.PersonSummary
+	8,   // 61: metadata.Character.role:type_name -> metadata.CharacterRole
+	50,  // 62: metadata.Character.voice_actors:type_name -> metadata.VoiceActor
+	16,  // 63: metadata.Character.image:type_name -> metadata.Image
+	51,  // 64: metadata.CharactersResponse.results:type_name -> metadata.Character
+	15,  // 65: metadata.FilmographyCredit.title:type_name -> metadata.Movie
+	54,  // 66: metadata.PersonDetails.filmography:type_name -> metadata.FilmographyCredit
+	16,  // 67: metadata.PersonDetails.profile:type_name -> metadata.Image
+	0,   // 68: metadata.GetRecommendationsRequest.media_type:type_name -> metadata.MediaType
+	15,  // 69: metadata.RecommendationsResponse.results:type_name -> metadata.Movie
+	0,   // 70: metadata.GetVideosRequest.media_type:type_name -> metadata.MediaType
+	9,   // 71: metadata.Video.type:type_name -> metadata.VideoType
+	59,  // 72: metadata.VideosResponse.results:type_name -> metadata.Video
+	0,   // 73: metadata.ResolveIDsRequest.media_type:type_name -> metadata.MediaType
+	0,   // 74: metadata.ResolveIDsResponse.media_type:type_name -> metadata.MediaType
+	72,  // 75: metadata.ResolveIDsResponse.ids:type_name -> metadata.ResolveIDsResponse.IdsEntry
+	65,  // 76: metadata.StatsResponse.cache:type_name -> metadata.CacheStats
+	66,  // 77: metadata.StatsResponse.upstream:type_name -> metadata.UpstreamStats
+	10,  // 78: metadata.MetadataService.GetPopularMovies:input_type -> metadata.GetPopularMoviesRequest
+	12,  // 79: metadata.MetadataService.GetMovieByID:input_type -> metadata.GetMovieByIDRequest
+	13,  // 80: metadata.MetadataService.SearchMovies:input_type -> metadata.SearchRequest
+	13,  // 81: metadata.MetadataService.SearchTVShows:input_type -> metadata.SearchRequest
+	19,  // 82: metadata.MetadataService.MultiSearch:input_type -> metadata.MultiSearchRequest
+	23,  // 83: metadata.MetadataService.GetPopularAnime:input_type -> metadata.GetPopularAnimeRequest
+	24,  // 84: metadata.MetadataService.DiscoverAnime:input_type -> metadata.DiscoverAnimeRequest
+	26,  // 85: metadata.MetadataService.Discover:input_type -> metadata.DiscoverRequest
+	28,  // 86: metadata.MetadataService.GetTrending:input_type -> metadata.GetTrendingRequest
+	30,  // 87: metadata.MetadataService.GetSeasonalChart:input_type -> metadata.GetSeasonalChartRequest
+	33,  // 88: metadata.MetadataService.GetAiringSchedule:input_type -> metadata.GetAiringScheduleRequest
+	36,  // 89: metadata.MetadataService.GetTVShowByID:input_type -> metadata.GetTVShowByIDRequest
+	37,  // 90: metadata.MetadataService.GetSeason:input_type -> metadata.GetSeasonRequest
+	38,  // 91: metadata.MetadataService.GetEpisode:input_type -> metadata.GetEpisodeRequest
+	41,  // 92: metadata.MetadataService.GetRelations:input_type -> metadata.GetRelationsRequest
+	45,  // 93: metadata.MetadataService.GetCredits:input_type -> metadata.GetCreditsRequest
+	49,  // 94: metadata.MetadataService.GetCharacters:input_type -> metadata.GetCharactersRequest
+	53,  // 95: metadata.MetadataService.GetPerson:input_type -> metadata.GetPersonRequest
+	56,  // 96: metadata.MetadataService.GetRecommendations:input_type -> metadata.GetRecommendationsRequest
+	56,  // 97: metadata.MetadataService.GetSimilar:input_type -> metadata.GetRecommendationsRequest
+	58,  // 98: metadata.MetadataService.GetVideos:input_type -> metadata.GetVideosRequest
+	61,  // 99: metadata.MetadataService.ResolveIDs:input_type -> metadata.ResolveIDsRequest
+	63,  // 100: metadata.MetadataService.GetStats:input_type -> metadata.GetStatsRequest
+	67,  // 101: metadata.MetadataService.PurgeCache:input_type -> metadata.PurgeCacheRequest
+	11,  // 102: metadata.MetadataService.GetPopularMovies:output_type -> metadata.GetPopularMoviesResponse
+	18,  // 103: metadata.MetadataService.GetMovieByID:output_type -> metadata.TitleDetails
+	14,  // 104: metadata.MetadataService.SearchMovies:output_type -> metadata.SearchResponse
+	14,  // 105: metadata.MetadataService.SearchTVShows:output_type -> metadata.SearchResponse
+	20,  // 106: metadata.MetadataService.MultiSearch:output_type -> metadata.MultiSearchResponse
+	25,  // 107: metadata.MetadataService.GetPopularAnime:output_type -> metadata.AnimeListResponse
+	25,  // 108: metadata.MetadataService.DiscoverAnime:output_type -> metadata.AnimeListResponse
+	27,  // 109: metadata.MetadataService.Discover:output_type -> metadata.DiscoverResponse
+	29,  // 110: metadata.MetadataService.GetTrending:output_type -> metadata.TrendingResponse
+	32,  // 111: metadata.MetadataService.GetSeasonalChart:output_type -> metadata.SeasonalChartResponse
+	35,  // 112: metadata.MetadataService.GetAiringSchedule:output_type -> metadata.AiringScheduleResponse
+	18,  // 113: metadata.MetadataService.GetTVShowByID:output_type -> metadata.TitleDetails
+	39,  // 114: metadata.MetadataService.GetSeason:output_type -> metadata.Season
+	40,  // 115: metadata.MetadataService.GetEpisode:output_type -> metadata.Episode
+	44,  // 116: metadata.MetadataService.GetRelations:output_type -> metadata.RelationsResponse
+	48,  // 117: metadata.MetadataService.GetCredits:output_type -> metadata.CreditsResponse
+	52,  // 118: metadata.MetadataService.GetCharacters:output_type -> metadata.CharactersResponse
+	55,  // 119: metadata.MetadataService.GetPerson:output_type -> metadata.PersonDetails
+	57,  // 120: metadata.MetadataService.GetRecommendations:output_type -> metadata.RecommendationsResponse
+	57,  // 121: metadata.MetadataService.GetSimilar:output_type -> metadata.RecommendationsResponse
+	60,  // 122: metadata.MetadataService.GetVideos:output_type -> metadata.VideosResponse
+	62,  // 123: metadata.MetadataService.ResolveIDs:output_type -> metadata.ResolveIDsResponse
+	64,  // 124: metadata.MetadataService.GetStats:output_type -> metadata.StatsResponse
+	68,  // 125: metadata.MetadataService.PurgeCache:output_type -> metadata.PurgeCacheResponse
+	102, // [102:126] is the sub-list for method output_type
+	78,  // [78:102] is the sub-list for method input_type
+	78,  // [78:78] is the sub-list for extension type_name
+	78,  // [78:78] is the sub-list for extension extendee
+	0,   // [0:78] is the sub-list for field type_name
+}
+
+func init() { file_metadata_proto_metadata_proto_init() }
+func file_metadata_proto_metadata_proto_init() {
+	if File_metadata_proto_metadata_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_metadata_proto_metadata_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPopularMoviesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPopularMoviesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMovieByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Movie); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Image); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Genre); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TitleDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSearchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiSearchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchHit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPopularAnimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverAnimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnimeListResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscoverResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrendingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrendingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonalChartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChartEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SeasonalChartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAiringScheduleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AiringEpisode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AiringScheduleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTVShowByIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSeasonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEpisodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Season); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -551,8 +6815,8 @@ func file_metadata_proto_metadata_proto_init() {
 				return nil
 			}
 		}
-		file_metadata_proto_metadata_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPopularMoviesResponse); i {
+		file_metadata_proto_metadata_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Episode); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -563,8 +6827,8 @@ func file_metadata_proto_metadata_proto_init() {
 				return nil
 			}
 		}
-		file_metadata_proto_metadata_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMovieByIDRequest); i {
+		file_metadata_proto_metadata_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -575,8 +6839,8 @@ func file_metadata_proto_metadata_proto_init() {
 				return nil
 			}
 		}
-		file_metadata_proto_metadata_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchRequest); i {
+		file_metadata_proto_metadata_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -587,8 +6851,8 @@ func file_metadata_proto_metadata_proto_init() {
 				return nil
 			}
 		}
-		file_metadata_proto_metadata_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchResponse); i {
+		file_metadata_proto_metadata_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchOrderEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -599,8 +6863,296 @@ func file_metadata_proto_metadata_proto_init() {
 				return nil
 			}
 		}
-		file_metadata_proto_metadata_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Movie); i {
+		file_metadata_proto_metadata_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCreditsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrewMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreditsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCharactersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoiceActor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Character); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CharactersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPersonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilmographyCredit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecommendationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVideosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Video); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VideosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveIDsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveIDsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpstreamStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_metadata_proto_metadata_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeCacheResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -617,13 +7169,14 @@ func file_metadata_proto_metadata_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_metadata_proto_metadata_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      10,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_metadata_proto_metadata_proto_goTypes,
 		DependencyIndexes: file_metadata_proto_metadata_proto_depIdxs,
+		EnumInfos:         file_metadata_proto_metadata_proto_enumTypes,
 		MessageInfos:      file_metadata_proto_metadata_proto_msgTypes,
 	}.Build()
 	File_metadata_proto_metadata_proto = out.File
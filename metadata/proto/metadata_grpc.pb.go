@@ -19,10 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetadataService_GetPopularMovies_FullMethodName = "/metadata.MetadataService/GetPopularMovies"
-	MetadataService_GetMovieByID_FullMethodName     = "/metadata.MetadataService/GetMovieByID"
-	MetadataService_SearchMovies_FullMethodName     = "/metadata.MetadataService/SearchMovies"
-	MetadataService_SearchTVShows_FullMethodName    = "/metadata.MetadataService/SearchTVShows"
+	MetadataService_GetPopularMovies_FullMethodName   = "/metadata.MetadataService/GetPopularMovies"
+	MetadataService_GetMovieByID_FullMethodName       = "/metadata.MetadataService/GetMovieByID"
+	MetadataService_SearchMovies_FullMethodName       = "/metadata.MetadataService/SearchMovies"
+	MetadataService_SearchTVShows_FullMethodName      = "/metadata.MetadataService/SearchTVShows"
+	MetadataService_MultiSearch_FullMethodName        = "/metadata.MetadataService/MultiSearch"
+	MetadataService_GetPopularAnime_FullMethodName    = "/metadata.MetadataService/GetPopularAnime"
+	MetadataService_DiscoverAnime_FullMethodName      = "/metadata.MetadataService/DiscoverAnime"
+	MetadataService_Discover_FullMethodName           = "/metadata.MetadataService/Discover"
+	MetadataService_GetTrending_FullMethodName        = "/metadata.MetadataService/GetTrending"
+	MetadataService_GetSeasonalChart_FullMethodName   = "/metadata.MetadataService/GetSeasonalChart"
+	MetadataService_GetAiringSchedule_FullMethodName  = "/metadata.MetadataService/GetAiringSchedule"
+	MetadataService_GetTVShowByID_FullMethodName      = "/metadata.MetadataService/GetTVShowByID"
+	MetadataService_GetSeason_FullMethodName          = "/metadata.MetadataService/GetSeason"
+	MetadataService_GetEpisode_FullMethodName         = "/metadata.MetadataService/GetEpisode"
+	MetadataService_GetRelations_FullMethodName       = "/metadata.MetadataService/GetRelations"
+	MetadataService_GetCredits_FullMethodName         = "/metadata.MetadataService/GetCredits"
+	MetadataService_GetCharacters_FullMethodName      = "/metadata.MetadataService/GetCharacters"
+	MetadataService_GetPerson_FullMethodName          = "/metadata.MetadataService/GetPerson"
+	MetadataService_GetRecommendations_FullMethodName = "/metadata.MetadataService/GetRecommendations"
+	MetadataService_GetSimilar_FullMethodName         = "/metadata.MetadataService/GetSimilar"
+	MetadataService_GetVideos_FullMethodName          = "/metadata.MetadataService/GetVideos"
+	MetadataService_ResolveIDs_FullMethodName         = "/metadata.MetadataService/ResolveIDs"
+	MetadataService_GetStats_FullMethodName           = "/metadata.MetadataService/GetStats"
+	MetadataService_PurgeCache_FullMethodName         = "/metadata.MetadataService/PurgeCache"
 )
 
 // MetadataServiceClient is the client API for MetadataService service.
@@ -34,11 +54,52 @@ type MetadataServiceClient interface {
 	// Получить популярные фильмы
 	GetPopularMovies(ctx context.Context, in *GetPopularMoviesRequest, opts ...grpc.CallOption) (*GetPopularMoviesResponse, error)
 	// Получить информацию о конкретном фильме по ID
-	GetMovieByID(ctx context.Context, in *GetMovieByIDRequest, opts ...grpc.CallOption) (*Movie, error)
+	GetMovieByID(ctx context.Context, in *GetMovieByIDRequest, opts ...grpc.CallOption) (*TitleDetails, error)
 	// Поиск фильмов по названию
 	SearchMovies(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
 	// Поиск сериалов по названию
 	SearchTVShows(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+	// Поиск по фильмам, сериалам и людям с общим ранжированием
+	MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error)
+	// Популярное аниме: японская анимация среди фильмов и сериалов
+	GetPopularAnime(ctx context.Context, in *GetPopularAnimeRequest, opts ...grpc.CallOption) (*AnimeListResponse, error)
+	// Подборка аниме с сортировкой
+	DiscoverAnime(ctx context.Context, in *DiscoverAnimeRequest, opts ...grpc.CallOption) (*AnimeListResponse, error)
+	// Подборка фильмов или сериалов по фильтрам
+	Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error)
+	// Тайтлы, набирающие популярность за день или неделю
+	GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error)
+	// Аниме, премьера которого пришлась на сезон года
+	GetSeasonalChart(ctx context.Context, in *GetSeasonalChartRequest, opts ...grpc.CallOption) (*SeasonalChartResponse, error)
+	// Расписание выхода эпизодов за период
+	GetAiringSchedule(ctx context.Context, in *GetAiringScheduleRequest, opts ...grpc.CallOption) (*AiringScheduleResponse, error)
+	// Получить информацию о сериале по ID
+	GetTVShowByID(ctx context.Context, in *GetTVShowByIDRequest, opts ...grpc.CallOption) (*TitleDetails, error)
+	// Получить сезон сериала со списком эпизодов
+	GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*Season, error)
+	// Получить эпизод сериала
+	GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error)
+	// Связанные тайтлы франшизы и рекомендуемый порядок просмотра
+	GetRelations(ctx context.Context, in *GetRelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error)
+	// Актёрский состав и съёмочная группа тайтла
+	GetCredits(ctx context.Context, in *GetCreditsRequest, opts ...grpc.CallOption) (*CreditsResponse, error)
+	// Персонажи аниме с японскими и локализованными сэйю
+	GetCharacters(ctx context.Context, in *GetCharactersRequest, opts ...grpc.CallOption) (*CharactersResponse, error)
+	// Карточка человека с фильмографией
+	GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*PersonDetails, error)
+	// Что посмотреть после тайтла: рекомендации зрителей
+	GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*RecommendationsResponse, error)
+	// Похожие тайтлы: совпадение жанров и ключевых слов
+	GetSimilar(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*RecommendationsResponse, error)
+	// Трейлеры, тизеры и другие видео тайтла
+	GetVideos(ctx context.Context, in *GetVideosRequest, opts ...grpc.CallOption) (*VideosResponse, error)
+	// Найти ID тайтла в других источниках (TMDb, AniList, MAL, Shikimori)
+	ResolveIDs(ctx context.Context, in *ResolveIDsRequest, opts ...grpc.CallOption) (*ResolveIDsResponse, error)
+	// Административные методы
+	// Получить статистику кэша ответов
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
+	// Удалить записи из кэша ответов
+	PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error)
 }
 
 type metadataServiceClient struct {
@@ -59,9 +120,9 @@ func (c *metadataServiceClient) GetPopularMovies(ctx context.Context, in *GetPop
 	return out, nil
 }
 
-func (c *metadataServiceClient) GetMovieByID(ctx context.Context, in *GetMovieByIDRequest, opts ...grpc.CallOption) (*Movie, error) {
+func (c *metadataServiceClient) GetMovieByID(ctx context.Context, in *GetMovieByIDRequest, opts ...grpc.CallOption) (*TitleDetails, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(Movie)
+	out := new(TitleDetails)
 	err := c.cc.Invoke(ctx, MetadataService_GetMovieByID_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -89,6 +150,206 @@ func (c *metadataServiceClient) SearchTVShows(ctx context.Context, in *SearchReq
 	return out, nil
 }
 
+func (c *metadataServiceClient) MultiSearch(ctx context.Context, in *MultiSearchRequest, opts ...grpc.CallOption) (*MultiSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiSearchResponse)
+	err := c.cc.Invoke(ctx, MetadataService_MultiSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetPopularAnime(ctx context.Context, in *GetPopularAnimeRequest, opts ...grpc.CallOption) (*AnimeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnimeListResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetPopularAnime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DiscoverAnime(ctx context.Context, in *DiscoverAnimeRequest, opts ...grpc.CallOption) (*AnimeListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnimeListResponse)
+	err := c.cc.Invoke(ctx, MetadataService_DiscoverAnime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) Discover(ctx context.Context, in *DiscoverRequest, opts ...grpc.CallOption) (*DiscoverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiscoverResponse)
+	err := c.cc.Invoke(ctx, MetadataService_Discover_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetTrending(ctx context.Context, in *GetTrendingRequest, opts ...grpc.CallOption) (*TrendingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TrendingResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetTrending_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetSeasonalChart(ctx context.Context, in *GetSeasonalChartRequest, opts ...grpc.CallOption) (*SeasonalChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SeasonalChartResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetSeasonalChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetAiringSchedule(ctx context.Context, in *GetAiringScheduleRequest, opts ...grpc.CallOption) (*AiringScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AiringScheduleResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetAiringSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetTVShowByID(ctx context.Context, in *GetTVShowByIDRequest, opts ...grpc.CallOption) (*TitleDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TitleDetails)
+	err := c.cc.Invoke(ctx, MetadataService_GetTVShowByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetSeason(ctx context.Context, in *GetSeasonRequest, opts ...grpc.CallOption) (*Season, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Season)
+	err := c.cc.Invoke(ctx, MetadataService_GetSeason_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetEpisode(ctx context.Context, in *GetEpisodeRequest, opts ...grpc.CallOption) (*Episode, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Episode)
+	err := c.cc.Invoke(ctx, MetadataService_GetEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetRelations(ctx context.Context, in *GetRelationsRequest, opts ...grpc.CallOption) (*RelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RelationsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetCredits(ctx context.Context, in *GetCreditsRequest, opts ...grpc.CallOption) (*CreditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetCredits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetCharacters(ctx context.Context, in *GetCharactersRequest, opts ...grpc.CallOption) (*CharactersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CharactersResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetCharacters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetPerson(ctx context.Context, in *GetPersonRequest, opts ...grpc.CallOption) (*PersonDetails, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonDetails)
+	err := c.cc.Invoke(ctx, MetadataService_GetPerson_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetRecommendations(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*RecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetSimilar(ctx context.Context, in *GetRecommendationsRequest, opts ...grpc.CallOption) (*RecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecommendationsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetSimilar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetVideos(ctx context.Context, in *GetVideosRequest, opts ...grpc.CallOption) (*VideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideosResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ResolveIDs(ctx context.Context, in *ResolveIDsRequest, opts ...grpc.CallOption) (*ResolveIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveIDsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ResolveIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StatsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) PurgeCache(ctx context.Context, in *PurgeCacheRequest, opts ...grpc.CallOption) (*PurgeCacheResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCacheResponse)
+	err := c.cc.Invoke(ctx, MetadataService_PurgeCache_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MetadataServiceServer is the server API for MetadataService service.
 // All implementations must embed UnimplementedMetadataServiceServer
 // for forward compatibility.
@@ -98,11 +359,52 @@ type MetadataServiceServer interface {
 	// Получить популярные фильмы
 	GetPopularMovies(context.Context, *GetPopularMoviesRequest) (*GetPopularMoviesResponse, error)
 	// Получить информацию о конкретном фильме по ID
-	GetMovieByID(context.Context, *GetMovieByIDRequest) (*Movie, error)
+	GetMovieByID(context.Context, *GetMovieByIDRequest) (*TitleDetails, error)
 	// Поиск фильмов по названию
 	SearchMovies(context.Context, *SearchRequest) (*SearchResponse, error)
 	// Поиск сериалов по названию
 	SearchTVShows(context.Context, *SearchRequest) (*SearchResponse, error)
+	// Поиск по фильмам, сериалам и людям с общим ранжированием
+	MultiSearch(context.Context, *MultiSearchRequest) (*MultiSearchResponse, error)
+	// Популярное аниме: японская анимация среди фильмов и сериалов
+	GetPopularAnime(context.Context, *GetPopularAnimeRequest) (*AnimeListResponse, error)
+	// Подборка аниме с сортировкой
+	DiscoverAnime(context.Context, *DiscoverAnimeRequest) (*AnimeListResponse, error)
+	// Подборка фильмов или сериалов по фильтрам
+	Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error)
+	// Тайтлы, набирающие популярность за день или неделю
+	GetTrending(context.Context, *GetTrendingRequest) (*TrendingResponse, error)
+	// Аниме, премьера которого пришлась на сезон года
+	GetSeasonalChart(context.Context, *GetSeasonalChartRequest) (*SeasonalChartResponse, error)
+	// Расписание выхода эпизодов за период
+	GetAiringSchedule(context.Context, *GetAiringScheduleRequest) (*AiringScheduleResponse, error)
+	// Получить информацию о сериале по ID
+	GetTVShowByID(context.Context, *GetTVShowByIDRequest) (*TitleDetails, error)
+	// Получить сезон сериала со списком эпизодов
+	GetSeason(context.Context, *GetSeasonRequest) (*Season, error)
+	// Получить эпизод сериала
+	GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error)
+	// Связанные тайтлы франшизы и рекомендуемый порядок просмотра
+	GetRelations(context.Context, *GetRelationsRequest) (*RelationsResponse, error)
+	// Актёрский состав и съёмочная группа тайтла
+	GetCredits(context.Context, *GetCreditsRequest) (*CreditsResponse, error)
+	// Персонажи аниме с японскими и локализованными сэйю
+	GetCharacters(context.Context, *GetCharactersRequest) (*CharactersResponse, error)
+	// Карточка человека с фильмографией
+	GetPerson(context.Context, *GetPersonRequest) (*PersonDetails, error)
+	// Что посмотреть после тайтла: рекомендации зрителей
+	GetRecommendations(context.Context, *GetRecommendationsRequest) (*RecommendationsResponse, error)
+	// Похожие тайтлы: совпадение жанров и ключевых слов
+	GetSimilar(context.Context, *GetRecommendationsRequest) (*RecommendationsResponse, error)
+	// Трейлеры, тизеры и другие видео тайтла
+	GetVideos(context.Context, *GetVideosRequest) (*VideosResponse, error)
+	// Найти ID тайтла в других источниках (TMDb, AniList, MAL, Shikimori)
+	ResolveIDs(context.Context, *ResolveIDsRequest) (*ResolveIDsResponse, error)
+	// Административные методы
+	// Получить статистику кэша ответов
+	GetStats(context.Context, *GetStatsRequest) (*StatsResponse, error)
+	// Удалить записи из кэша ответов
+	PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error)
 	mustEmbedUnimplementedMetadataServiceServer()
 }
 
@@ -116,7 +418,7 @@ type UnimplementedMetadataServiceServer struct{}
 func (UnimplementedMetadataServiceServer) GetPopularMovies(context.Context, *GetPopularMoviesRequest) (*GetPopularMoviesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPopularMovies not implemented")
 }
-func (UnimplementedMetadataServiceServer) GetMovieByID(context.Context, *GetMovieByIDRequest) (*Movie, error) {
+func (UnimplementedMetadataServiceServer) GetMovieByID(context.Context, *GetMovieByIDRequest) (*TitleDetails, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMovieByID not implemented")
 }
 func (UnimplementedMetadataServiceServer) SearchMovies(context.Context, *SearchRequest) (*SearchResponse, error) {
@@ -125,6 +427,66 @@ func (UnimplementedMetadataServiceServer) SearchMovies(context.Context, *SearchR
 func (UnimplementedMetadataServiceServer) SearchTVShows(context.Context, *SearchRequest) (*SearchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchTVShows not implemented")
 }
+func (UnimplementedMetadataServiceServer) MultiSearch(context.Context, *MultiSearchRequest) (*MultiSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSearch not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetPopularAnime(context.Context, *GetPopularAnimeRequest) (*AnimeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPopularAnime not implemented")
+}
+func (UnimplementedMetadataServiceServer) DiscoverAnime(context.Context, *DiscoverAnimeRequest) (*AnimeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiscoverAnime not implemented")
+}
+func (UnimplementedMetadataServiceServer) Discover(context.Context, *DiscoverRequest) (*DiscoverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Discover not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetTrending(context.Context, *GetTrendingRequest) (*TrendingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrending not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetSeasonalChart(context.Context, *GetSeasonalChartRequest) (*SeasonalChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeasonalChart not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetAiringSchedule(context.Context, *GetAiringScheduleRequest) (*AiringScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAiringSchedule not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetTVShowByID(context.Context, *GetTVShowByIDRequest) (*TitleDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTVShowByID not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetSeason(context.Context, *GetSeasonRequest) (*Season, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeason not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetEpisode(context.Context, *GetEpisodeRequest) (*Episode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEpisode not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetRelations(context.Context, *GetRelationsRequest) (*RelationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelations not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetCredits(context.Context, *GetCreditsRequest) (*CreditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredits not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetCharacters(context.Context, *GetCharactersRequest) (*CharactersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCharacters not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetPerson(context.Context, *GetPersonRequest) (*PersonDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPerson not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetRecommendations(context.Context, *GetRecommendationsRequest) (*RecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecommendations not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetSimilar(context.Context, *GetRecommendationsRequest) (*RecommendationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSimilar not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetVideos(context.Context, *GetVideosRequest) (*VideosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVideos not implemented")
+}
+func (UnimplementedMetadataServiceServer) ResolveIDs(context.Context, *ResolveIDsRequest) (*ResolveIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveIDs not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetStats(context.Context, *GetStatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedMetadataServiceServer) PurgeCache(context.Context, *PurgeCacheRequest) (*PurgeCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCache not implemented")
+}
 func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
 func (UnimplementedMetadataServiceServer) testEmbeddedByValue()                         {}
 
@@ -218,6 +580,366 @@ func _MetadataService_SearchTVShows_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MetadataService_MultiSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).MultiSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_MultiSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).MultiSearch(ctx, req.(*MultiSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetPopularAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPopularAnimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetPopularAnime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetPopularAnime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetPopularAnime(ctx, req.(*GetPopularAnimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DiscoverAnime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverAnimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DiscoverAnime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_DiscoverAnime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DiscoverAnime(ctx, req.(*DiscoverAnimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_Discover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiscoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Discover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_Discover_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Discover(ctx, req.(*DiscoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetTrending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetTrending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetTrending_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetTrending(ctx, req.(*GetTrendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetSeasonalChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonalChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetSeasonalChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetSeasonalChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetSeasonalChart(ctx, req.(*GetSeasonalChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetAiringSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAiringScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetAiringSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetAiringSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetAiringSchedule(ctx, req.(*GetAiringScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetTVShowByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTVShowByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetTVShowByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetTVShowByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetTVShowByID(ctx, req.(*GetTVShowByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetSeason_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeasonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetSeason(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetSeason_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetSeason(ctx, req.(*GetSeasonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEpisodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetEpisode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetEpisode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetEpisode(ctx, req.(*GetEpisodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetRelations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetRelations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetRelations(ctx, req.(*GetRelationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetCredits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetCredits(ctx, req.(*GetCreditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetCharacters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCharactersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetCharacters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetCharacters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetCharacters(ctx, req.(*GetCharactersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetPerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetPerson(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetPerson_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetPerson(ctx, req.(*GetPersonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetRecommendations(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetSimilar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetSimilar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetSimilar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetSimilar(ctx, req.(*GetRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetVideos(ctx, req.(*GetVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ResolveIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ResolveIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ResolveIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ResolveIDs(ctx, req.(*ResolveIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_PurgeCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).PurgeCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_PurgeCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).PurgeCache(ctx, req.(*PurgeCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MetadataService_ServiceDesc is the grpc.ServiceDesc for MetadataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -241,6 +963,86 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchTVShows",
 			Handler:    _MetadataService_SearchTVShows_Handler,
 		},
+		{
+			MethodName: "MultiSearch",
+			Handler:    _MetadataService_MultiSearch_Handler,
+		},
+		{
+			MethodName: "GetPopularAnime",
+			Handler:    _MetadataService_GetPopularAnime_Handler,
+		},
+		{
+			MethodName: "DiscoverAnime",
+			Handler:    _MetadataService_DiscoverAnime_Handler,
+		},
+		{
+			MethodName: "Discover",
+			Handler:    _MetadataService_Discover_Handler,
+		},
+		{
+			MethodName: "GetTrending",
+			Handler:    _MetadataService_GetTrending_Handler,
+		},
+		{
+			MethodName: "GetSeasonalChart",
+			Handler:    _MetadataService_GetSeasonalChart_Handler,
+		},
+		{
+			MethodName: "GetAiringSchedule",
+			Handler:    _MetadataService_GetAiringSchedule_Handler,
+		},
+		{
+			MethodName: "GetTVShowByID",
+			Handler:    _MetadataService_GetTVShowByID_Handler,
+		},
+		{
+			MethodName: "GetSeason",
+			Handler:    _MetadataService_GetSeason_Handler,
+		},
+		{
+			MethodName: "GetEpisode",
+			Handler:    _MetadataService_GetEpisode_Handler,
+		},
+		{
+			MethodName: "GetRelations",
+			Handler:    _MetadataService_GetRelations_Handler,
+		},
+		{
+			MethodName: "GetCredits",
+			Handler:    _MetadataService_GetCredits_Handler,
+		},
+		{
+			MethodName: "GetCharacters",
+			Handler:    _MetadataService_GetCharacters_Handler,
+		},
+		{
+			MethodName: "GetPerson",
+			Handler:    _MetadataService_GetPerson_Handler,
+		},
+		{
+			MethodName: "GetRecommendations",
+			Handler:    _MetadataService_GetRecommendations_Handler,
+		},
+		{
+			MethodName: "GetSimilar",
+			Handler:    _MetadataService_GetSimilar_Handler,
+		},
+		{
+			MethodName: "GetVideos",
+			Handler:    _MetadataService_GetVideos_Handler,
+		},
+		{
+			MethodName: "ResolveIDs",
+			Handler:    _MetadataService_ResolveIDs_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _MetadataService_GetStats_Handler,
+		},
+		{
+			MethodName: "PurgeCache",
+			Handler:    _MetadataService_PurgeCache_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "metadata/proto/metadata.proto",
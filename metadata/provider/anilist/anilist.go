@@ -0,0 +1,344 @@
+// Package anilist реализует provider.Provider поверх GraphQL API AniList.
+//
+// AniList знает только аниме и мангу, поэтому сезонов и эпизодов в смысле
+// TMDb у него нет, а заголовки не локализуются: для английского языка
+// отдаётся английское название, для остальных — ромадзи.
+package anilist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// DefaultBaseURL — адрес GraphQL API AniList.
+const DefaultBaseURL = "https://graphql.anilist.co"
+
+// perPage — размер страницы списков, как у TMDb.
+const perPage = 20
+
+// Provider получает метаданные аниме из AniList.
+type Provider struct {
+	baseURL string
+	client  *httpclient.Client
+
+	inflight provider.Coalescer
+}
+
+var (
+	_ provider.Provider      = (*Provider)(nil)
+	_ provider.StatsReporter = (*Provider)(nil)
+)
+
+func New(baseURL string, client *httpclient.Client) *Provider {
+	return &Provider{baseURL: baseURL, client: client}
+}
+
+func (p *Provider) Name() string {
+	return "anilist"
+}
+
+func (p *Provider) UpstreamStats() provider.UpstreamStats {
+	return p.inflight.Stats()
+}
+
+func (p *Provider) PopularMovies(ctx context.Context, page int32, language string) (*provider.MoviePage, error) {
+	return p.mediaPage(ctx, language, pageVariables{
+		Page:   page,
+		Sort:   []string{"POPULARITY_DESC"},
+		Format: movieFormats,
+	})
+}
+
+func (p *Provider) SearchMovies(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	return p.mediaPage(ctx, language, pageVariables{
+		Page:   page,
+		Search: query,
+		Sort:   []string{"SEARCH_MATCH"},
+		Format: movieFormats,
+	})
+}
+
+func (p *Provider) SearchTVShows(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	return p.mediaPage(ctx, language, pageVariables{
+		Page:   page,
+		Search: query,
+		Sort:   []string{"SEARCH_MATCH"},
+		Format: tvFormats,
+	})
+}
+
+func (p *Provider) SearchPeople(ctx context.Context, query string, page int32, language string) (*provider.PersonPage, error) {
+	var data struct {
+		Page struct {
+			PageInfo pageInfo `json:"pageInfo"`
+			Staff    []staff  `json:"staff"`
+		} `json:"Page"`
+	}
+	if err := p.query(ctx, staffSearchQuery, map[string]any{
+		"page":    max(page, 1),
+		"perPage": perPage,
+		"search":  query,
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Найдено %d человек в AniList", len(data.Page.Staff))
+
+	result := &provider.PersonPage{
+		Page:         data.Page.PageInfo.CurrentPage,
+		TotalPages:   data.Page.PageInfo.LastPage,
+		TotalResults: data.Page.PageInfo.Total,
+	}
+	for _, s := range data.Page.Staff {
+		person := s.summary()
+		for _, m := range s.StaffMedia.Nodes {
+			person.KnownFor = append(person.KnownFor, m.toMovie(language))
+		}
+		result.Results = append(result.Results, person)
+	}
+	return result, nil
+}
+
+func (p *Provider) MovieByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	return p.mediaByID(ctx, id, language)
+}
+
+func (p *Provider) TVShowByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	return p.mediaByID(ctx, id, language)
+}
+
+func (p *Provider) Season(ctx context.Context, tvID int64, seasonNumber int32, language string) (*pb.Season, error) {
+	return nil, provider.Unsupported(p.Name(), "получение сезонов")
+}
+
+func (p *Provider) Episode(ctx context.Context, tvID int64, seasonNumber, episodeNumber int32, language string) (*pb.Episode, error) {
+	return nil, provider.Unsupported(p.Name(), "получение эпизодов")
+}
+
+func (p *Provider) Discover(ctx context.Context, mediaType pb.MediaType, query provider.DiscoverQuery) (*provider.MoviePage, error) {
+	for _, g := range slices.Concat(query.Genres, query.ExcludeGenres) {
+		if g != provider.GenreAnimation {
+			return nil, provider.Unsupported(p.Name(), "фильтр по жанрам TMDb")
+		}
+	}
+	if slices.Contains(query.ExcludeGenres, provider.GenreAnimation) {
+		// В AniList только анимация.
+		return &provider.MoviePage{Page: max(query.Page, 1)}, nil
+	}
+
+	vars := pageVariables{
+		Page: query.Page,
+		Sort: []string{sortParam(query.SortBy)},
+	}
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		vars.Format = movieFormats
+	case pb.MediaType_MEDIA_TYPE_TV:
+		vars.Format = tvFormats
+	default:
+		return nil, provider.Unsupported(p.Name(), fmt.Sprintf("подбор для типа %s", mediaType))
+	}
+
+	switch query.OriginCountry {
+	case "":
+		if query.OriginalLanguage == "ja" {
+			vars.Country = "JP"
+		}
+	default:
+		vars.Country = query.OriginCountry
+	}
+	vars.StartFrom = fuzzyDateInt(query.ReleasedFrom)
+	vars.StartTo = fuzzyDateInt(query.ReleasedTo)
+	// Числа голосов AniList не отдаёт; ближе всего к нему популярность —
+	// число пользователей с тайтлом в списках, которое не меньше числа
+	// оценок.
+	vars.MinScore = int32(math.Ceil(query.MinRating * 10))
+	vars.MinPopularity = query.MinVotes
+	vars.MinDuration = query.MinRuntime
+	vars.MaxDuration = query.MaxRuntime
+
+	return p.mediaPage(ctx, query.Language, vars)
+}
+
+func (p *Provider) mediaByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	var data struct {
+		Media media `json:"Media"`
+	}
+	if err := p.query(ctx, mediaByIDQuery, map[string]any{"id": id}, &data); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получено аниме из AniList: %s", data.Media.Title.Romaji)
+	return data.Media.toDetails(language), nil
+}
+
+// pageVariables — переменные запроса pageQuery. Пустые поля в запрос
+// не передаются.
+type pageVariables struct {
+	Page    int32
+	Search  string
+	Sort    []string
+	Format  []string
+	Country string
+	// Season и SeasonYear выбирают аниме сезона (WINTER, SPRING, ...).
+	Season     string
+	SeasonYear int32
+	// StartFrom и StartTo ограничивают дату премьеры (YYYYMMDD, включительно).
+	StartFrom int
+	StartTo   int
+	// MinScore — нижняя граница оценки (0–100), MinPopularity — числа
+	// пользователей, добавивших тайтл в списки, включительно.
+	MinScore      int32
+	MinPopularity int32
+	// MinDuration и MaxDuration ограничивают длительность эпизода в
+	// минутах, включительно.
+	MinDuration int32
+	MaxDuration int32
+}
+
+func (p *Provider) mediaPage(ctx context.Context, language string, v pageVariables) (*provider.MoviePage, error) {
+	info, items, err := p.fetchPage(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &provider.MoviePage{
+		Page:         info.CurrentPage,
+		TotalPages:   info.LastPage,
+		TotalResults: info.Total,
+	}
+	for _, m := range items {
+		result.Results = append(result.Results, m.toMovie(language))
+	}
+	return result, nil
+}
+
+func (p *Provider) fetchPage(ctx context.Context, v pageVariables) (pageInfo, []media, error) {
+	vars := map[string]any{
+		"page":    max(v.Page, 1),
+		"perPage": perPage,
+		"sort":    v.Sort,
+	}
+	if len(v.Format) > 0 {
+		vars["format"] = v.Format
+	}
+	if v.Search != "" {
+		vars["search"] = v.Search
+	}
+	if v.Country != "" {
+		vars["country"] = v.Country
+	}
+	if v.Season != "" {
+		vars["season"] = v.Season
+		vars["seasonYear"] = v.SeasonYear
+	}
+	if v.StartFrom != 0 {
+		vars["startFrom"] = v.StartFrom - 1
+	}
+	if v.StartTo != 0 {
+		vars["startTo"] = v.StartTo + 1
+	}
+	if v.MinScore != 0 {
+		vars["scoreFrom"] = v.MinScore - 1
+	}
+	if v.MinPopularity != 0 {
+		vars["popularityFrom"] = v.MinPopularity - 1
+	}
+	if v.MinDuration != 0 {
+		vars["durationFrom"] = v.MinDuration - 1
+	}
+	if v.MaxDuration != 0 {
+		vars["durationTo"] = v.MaxDuration + 1
+	}
+
+	var data struct {
+		Page struct {
+			PageInfo pageInfo `json:"pageInfo"`
+			Media    []media  `json:"media"`
+		} `json:"Page"`
+	}
+	if err := p.query(ctx, pageQuery, vars, &data); err != nil {
+		return pageInfo{}, nil, err
+	}
+
+	log.Printf("Получено %d аниме из AniList", len(data.Page.Media))
+	return data.Page.PageInfo, data.Page.Media, nil
+}
+
+// query выполняет GraphQL-запрос и декодирует поле data ответа в out.
+func (p *Provider) query(ctx context.Context, query string, variables map[string]any, out any) error {
+	payload, err := json.Marshal(map[string]any{"query": query, "variables": variables})
+	if err != nil {
+		return err
+	}
+
+	body, err := p.inflight.Do(ctx, string(payload), func(ctx context.Context) ([]byte, error) {
+		log.Printf("Выполняю запрос к AniList")
+		return p.client.Post(ctx, p.baseURL, "application/json", payload)
+	})
+	if err != nil {
+		return provider.Classify(p.Name(), err)
+	}
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+			Status  int    `json:"status"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return provider.NewError(p.Name(), provider.KindMalformed,
+			fmt.Errorf("ошибка при декодировании ответа от AniList: %w", err))
+	}
+	if len(envelope.Errors) > 0 {
+		kind := provider.KindUnavailable
+		if envelope.Errors[0].Status == 404 {
+			kind = provider.KindNotFound
+		}
+		return provider.NewError(p.Name(), kind, fmt.Errorf("AniList вернул ошибку: %s", envelope.Errors[0].Message))
+	}
+
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return provider.NewError(p.Name(), provider.KindMalformed,
+			fmt.Errorf("ошибка при декодировании ответа от AniList: %w", err))
+	}
+	return nil
+}
+
+func sortParam(sortBy pb.SortOrder) string {
+	switch sortBy {
+	case pb.SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC:
+		return "SCORE_DESC"
+	case pb.SortOrder_SORT_ORDER_RELEASE_DATE_DESC:
+		return "START_DATE_DESC"
+	case pb.SortOrder_SORT_ORDER_RELEASE_DATE_ASC:
+		return "START_DATE"
+	case pb.SortOrder_SORT_ORDER_TITLE_ASC:
+		return "TITLE_ROMAJI"
+	default:
+		return "POPULARITY_DESC"
+	}
+}
+
+var (
+	lineBreak = regexp.MustCompile(`(?i)<br\s*/?>`)
+	htmlTag   = regexp.MustCompile(`<[^>]*>`)
+)
+
+// plainText убирает из описания AniList HTML-разметку, заменяя <br>
+// переводами строк.
+func plainText(description string) string {
+	description = lineBreak.ReplaceAllString(description, "\n")
+	return strings.TrimSpace(htmlTag.ReplaceAllString(description, ""))
+}
@@ -0,0 +1,278 @@
+package anilist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// fixture — ответ AniList в формате записей cmd/replay.
+type fixture struct {
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type"`
+	Body        json.RawMessage `json:"body,omitempty"`
+	Text        string          `json:"text,omitempty"`
+}
+
+// graphQLRequest — тело запроса, полученное заглушкой.
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+// replay запускает заглушку AniList, которая на каждый запрос отдаёт
+// ответ из testdata/name.json, и возвращает источник, направленный на
+// неё, и функцию, возвращающую полученные заглушкой запросы.
+func replay(t *testing.T, name string) (*Provider, func() []graphQLRequest) {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatalf("некорректная запись %s: %v", name, err)
+	}
+
+	var (
+		mu       sync.Mutex
+		requests []graphQLRequest
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || json.Unmarshal(body, &req) != nil {
+			http.Error(w, "ожидался GraphQL-запрос", http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		requests = append(requests, req)
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", f.ContentType)
+		w.WriteHeader(f.Status)
+		if f.Body != nil {
+			w.Write(f.Body)
+		} else {
+			io.WriteString(w, f.Text)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	p := New(srv.URL, httpclient.New(httpclient.Config{}))
+	return p, func() []graphQLRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return slices.Clone(requests)
+	}
+}
+
+func TestSearchTVShows(t *testing.T) {
+	p, requests := replay(t, "search_tv")
+
+	page, err := p.SearchTVShows(context.Background(), "shingeki", 1, "en-US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := requests()
+	if len(reqs) != 1 {
+		t.Fatalf("запросов к AniList: %d, want 1", len(reqs))
+	}
+	vars := reqs[0].Variables
+	if vars["search"] != "shingeki" || vars["page"] != float64(1) || vars["perPage"] != float64(perPage) {
+		t.Errorf("переменные запроса: %v", vars)
+	}
+	if got := vars["format"]; len(got.([]any)) != len(tvFormats) {
+		t.Errorf("format = %v, want %v", got, tvFormats)
+	}
+	if _, ok := vars["country"]; ok {
+		t.Errorf("пустая страна передана в запрос: %v", vars)
+	}
+
+	if page.Page != 1 || page.TotalPages != 1 || page.TotalResults != 2 || len(page.Results) != 2 {
+		t.Fatalf("страница = %+v", page)
+	}
+
+	first := page.Results[0]
+	want := &pb.Movie{
+		Id:            16498,
+		Title:         "Attack on Titan",
+		OriginalTitle: "進撃の巨人",
+		Overview:      "Several hundred years ago, humans were nearly exterminated by Titans.\n\n\n(Source: Kodansha)",
+		ReleaseDate:   "2013-04-07",
+		VoteAverage:   8.4,
+		MediaType:     pb.MediaType_MEDIA_TYPE_TV,
+		Popularity:    923456,
+		AlternativeTitles: []string{
+			"Shingeki no Kyojin", "SnK", "AoT", "Атака титанов",
+		},
+	}
+	if first.GetId() != want.Id || first.GetTitle() != want.Title || first.GetOriginalTitle() != want.OriginalTitle ||
+		first.GetOverview() != want.Overview ||
+		first.GetReleaseDate() != want.ReleaseDate || first.GetVoteAverage() != want.VoteAverage ||
+		first.GetMediaType() != want.MediaType || first.GetPopularity() != want.Popularity ||
+		!slices.Equal(first.GetAlternativeTitles(), want.AlternativeTitles) {
+		t.Errorf("первый результат:\n got %v\nwant %v", first, want)
+	}
+	if first.GetPoster().GetPath() == "" || first.GetBackdrop().GetPath() == "" {
+		t.Errorf("нет постера или фона: %v", first)
+	}
+
+	// Без английского названия и описания, с неизвестными месяцем и днём.
+	second := page.Results[1]
+	if second.GetTitle() != "Shingeki no Kyojin Season 2" || second.GetOverview() != "" || second.GetReleaseDate() != "2017-01-01" {
+		t.Errorf("второй результат: %v", second)
+	}
+	if second.GetPoster() != nil || second.GetBackdrop() != nil {
+		t.Errorf("пустые изображения не должны попадать в ответ: %v", second)
+	}
+}
+
+func TestTitleLanguage(t *testing.T) {
+	p, _ := replay(t, "search_tv")
+
+	page, err := p.SearchTVShows(context.Background(), "shingeki", 1, "ru-RU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := page.Results[0]
+	if m.GetTitle() != "Shingeki no Kyojin" {
+		t.Errorf("Title = %q, want ромадзи", m.GetTitle())
+	}
+	if !slices.Contains(m.GetAlternativeTitles(), "Attack on Titan") || slices.Contains(m.GetAlternativeTitles(), "Shingeki no Kyojin") {
+		t.Errorf("AlternativeTitles = %q", m.GetAlternativeTitles())
+	}
+}
+
+func TestMediaByID(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		fetch   func(p *Provider) (*pb.TitleDetails, error)
+		check   func(t *testing.T, d *pb.TitleDetails)
+	}{
+		{
+			name:    "идущий сериал",
+			fixture: "media_21",
+			fetch: func(p *Provider) (*pb.TitleDetails, error) {
+				return p.TVShowByID(context.Background(), 21, "en-US")
+			},
+			check: func(t *testing.T, d *pb.TitleDetails) {
+				if d.GetMediaType() != pb.MediaType_MEDIA_TYPE_TV || d.GetStatus() != "RELEASING" {
+					t.Errorf("тип и статус: %v, %q", d.GetMediaType(), d.GetStatus())
+				}
+				if d.GetOverview() != "Gold Roger was known as the Pirate King.\n\nHis death sparked the Grand Age of Pirates." {
+					t.Errorf("Overview = %q", d.GetOverview())
+				}
+				if d.GetOriginalLanguage() != "ja" || !slices.Equal(d.GetOriginCountry(), []string{"JP"}) {
+					t.Errorf("язык и страна: %q, %q", d.GetOriginalLanguage(), d.GetOriginCountry())
+				}
+				if ids := d.GetExternalIds(); ids["anilist"] != "21" || ids["mal"] != "21" {
+					t.Errorf("ExternalIds = %v", ids)
+				}
+				if d.GetNextEpisode().GetEpisodeNumber() != 1148 || d.GetNextEpisode().GetAirsAtUnix() != 1760835600 {
+					t.Errorf("NextEpisode = %v", d.GetNextEpisode())
+				}
+				if d.GetNumberOfEpisodes() != 0 || d.GetNumberOfSeasons() != 0 || d.GetLastAirDate() != "" {
+					t.Errorf("эпизоды неизвестны: %d, %d, %q", d.GetNumberOfEpisodes(), d.GetNumberOfSeasons(), d.GetLastAirDate())
+				}
+				if len(d.GetGenres()) != 3 || d.GetGenres()[0].GetName() != "Action" {
+					t.Errorf("Genres = %v", d.GetGenres())
+				}
+			},
+		},
+		{
+			name:    "фильм",
+			fixture: "media_movie_199",
+			fetch: func(p *Provider) (*pb.TitleDetails, error) {
+				return p.MovieByID(context.Background(), 199, "ja-JP")
+			},
+			check: func(t *testing.T, d *pb.TitleDetails) {
+				if d.GetMediaType() != pb.MediaType_MEDIA_TYPE_MOVIE || d.GetRuntime() != 125 {
+					t.Errorf("тип и длительность: %v, %d", d.GetMediaType(), d.GetRuntime())
+				}
+				if d.GetTitle() != "Sen to Chihiro no Kamikakushi" || d.GetOriginalTitle() != "千と千尋の神隠し" {
+					t.Errorf("названия: %q, %q", d.GetTitle(), d.GetOriginalTitle())
+				}
+				if d.GetNextEpisode() != nil || d.GetNumberOfEpisodes() != 0 {
+					t.Errorf("у фильма не должно быть эпизодов: %v", d)
+				}
+				if d.GetVoteAverage() != 8.6 || d.GetReleaseDate() != "2001-07-20" {
+					t.Errorf("оценка и дата: %v, %q", d.GetVoteAverage(), d.GetReleaseDate())
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, requests := replay(t, tt.fixture)
+			d, err := tt.fetch(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reqs := requests(); len(reqs) != 1 || reqs[0].Variables["id"] != float64(d.GetId()) {
+				t.Errorf("запросы: %v", reqs)
+			}
+			tt.check(t, d)
+		})
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		fixture string
+		want    provider.ErrorKind
+	}{
+		{"not_found", provider.KindNotFound},
+		{"graphql_not_found", provider.KindNotFound},
+		{"graphql_error", provider.KindUnavailable},
+		{"rate_limited", provider.KindRateLimited},
+		{"server_error", provider.KindUnavailable},
+		{"malformed", provider.KindMalformed},
+		{"unexpected_shape", provider.KindMalformed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			p, _ := replay(t, tt.fixture)
+			_, err := p.TVShowByID(context.Background(), 1, "en-US")
+
+			var providerErr *provider.Error
+			if !errors.As(err, &providerErr) {
+				t.Fatalf("ошибка %v (%T), want *provider.Error", err, err)
+			}
+			if providerErr.Kind != tt.want || providerErr.Provider != "anilist" {
+				t.Errorf("Kind = %v, Provider = %q, want %v, anilist", providerErr.Kind, providerErr.Provider, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	p, requests := replay(t, "search_tv")
+
+	_, err := p.Season(context.Background(), 16498, 1, "en-US")
+	var providerErr *provider.Error
+	if !errors.As(err, &providerErr) || providerErr.Kind != provider.KindUnsupported {
+		t.Errorf("Season: %v, want KindUnsupported", err)
+	}
+	_, err = p.Discover(context.Background(), pb.MediaType_MEDIA_TYPE_TV, provider.DiscoverQuery{Genres: []int32{28}})
+	if !errors.As(err, &providerErr) || providerErr.Kind != provider.KindUnsupported {
+		t.Errorf("Discover с жанром TMDb: %v, want KindUnsupported", err)
+	}
+	if len(requests()) != 0 {
+		t.Error("неподдерживаемые запросы не должны доходить до AniList")
+	}
+}
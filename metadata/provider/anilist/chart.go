@@ -0,0 +1,64 @@
+package anilist
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+func (p *Provider) SeasonalChart(ctx context.Context, query provider.SeasonQuery) (*provider.ChartPage, error) {
+	vars := pageVariables{
+		Page:       query.Page,
+		Sort:       []string{sortParam(query.SortBy)},
+		Season:     strings.TrimPrefix(query.Season.String(), "ANIME_SEASON_"),
+		SeasonYear: query.Year,
+	}
+	for _, f := range query.Formats {
+		vars.Format = append(vars.Format, strings.TrimPrefix(f.String(), "TITLE_FORMAT_"))
+	}
+
+	info, items, err := p.fetchPage(ctx, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &provider.ChartPage{
+		Page:         info.CurrentPage,
+		TotalPages:   info.LastPage,
+		TotalResults: info.Total,
+	}
+	for _, m := range items {
+		result.Results = append(result.Results, &pb.ChartEntry{
+			Title:        m.toMovie(query.Language),
+			Format:       m.format(),
+			Episodes:     m.Episodes,
+			AiringStatus: m.airingStatus(),
+		})
+	}
+	return result, nil
+}
+
+// format переводит формат AniList в TitleFormat. Названия значений
+// совпадают, кроме префикса.
+func (m media) format() pb.TitleFormat {
+	return pb.TitleFormat(pb.TitleFormat_value["TITLE_FORMAT_"+m.Format])
+}
+
+func (m media) airingStatus() pb.AiringStatus {
+	switch m.Status {
+	case "RELEASING":
+		return pb.AiringStatus_AIRING_STATUS_AIRING
+	case "FINISHED":
+		return pb.AiringStatus_AIRING_STATUS_FINISHED
+	case "NOT_YET_RELEASED":
+		return pb.AiringStatus_AIRING_STATUS_NOT_YET_AIRED
+	case "CANCELLED":
+		return pb.AiringStatus_AIRING_STATUS_CANCELLED
+	case "HIATUS":
+		return pb.AiringStatus_AIRING_STATUS_HIATUS
+	default:
+		return pb.AiringStatus_AIRING_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,334 @@
+package anilist
+
+import (
+	"context"
+	"log"
+	"slices"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// maxCredits — сколько персонажей и участников съёмочной группы
+// запрашивается в Credits; больше AniList не отдаёт за один запрос.
+const maxCredits = 50
+
+const staffFields = `
+fragment staffFields on Staff {
+  id
+  name { full native }
+  image { large }
+  primaryOccupations
+  favourites
+  languageV2
+}`
+
+const creditsQuery = `
+query ($id: Int, $perPage: Int) {
+  Media(id: $id, type: ANIME) {
+    characters(perPage: $perPage, sort: [ROLE, RELEVANCE, ID]) {
+      edges {
+        node { name { full } }
+        voiceActors(language: JAPANESE, sort: [RELEVANCE, ID]) { ...staffFields }
+      }
+    }
+    staff(perPage: $perPage, sort: [RELEVANCE, ID]) {
+      edges {
+        role
+        node { ...staffFields }
+      }
+    }
+  }
+}` + staffFields
+
+const charactersQuery = `
+query ($id: Int, $page: Int, $perPage: Int) {
+  Media(id: $id, type: ANIME) {
+    characters(page: $page, perPage: $perPage, sort: [ROLE, RELEVANCE, ID]) {
+      pageInfo { total currentPage lastPage }
+      edges {
+        role
+        node { id name { full native } image { large } }
+        voiceActors(sort: [RELEVANCE, ID]) { ...staffFields }
+      }
+    }
+  }
+}` + staffFields
+
+const personQuery = `
+query ($id: Int, $perPage: Int) {
+  Staff(id: $id) {
+    ...staffFields
+    description(asHtml: false)
+    dateOfBirth { year month day }
+    dateOfDeath { year month day }
+    homeTown
+    characterMedia(perPage: $perPage, sort: START_DATE_DESC) {
+      edges {
+        characters { name { full } }
+        node { type ...mediaFields }
+      }
+    }
+    staffMedia(perPage: $perPage, type: ANIME, sort: START_DATE_DESC) {
+      edges {
+        staffRole
+        node { type ...mediaFields }
+      }
+    }
+  }
+}` + staffFields + mediaFields
+
+type characterName struct {
+	Name struct {
+		Full   string `json:"full"`
+		Native string `json:"native"`
+	} `json:"name"`
+}
+
+// Credits возвращает японских сэйю главных персонажей и съёмочную группу.
+// Отделов AniList не различает, поэтому у съёмочной группы заполнена
+// только должность.
+func (p *Provider) Credits(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.Credits, error) {
+	var data struct {
+		Media struct {
+			Characters struct {
+				Edges []struct {
+					Node        characterName `json:"node"`
+					VoiceActors []staff       `json:"voiceActors"`
+				} `json:"edges"`
+			} `json:"characters"`
+			Staff struct {
+				Edges []struct {
+					Role string `json:"role"`
+					Node staff  `json:"node"`
+				} `json:"edges"`
+			} `json:"staff"`
+		} `json:"Media"`
+	}
+	if err := p.query(ctx, creditsQuery, map[string]any{"id": id, "perPage": maxCredits}, &data); err != nil {
+		return nil, err
+	}
+
+	credits := &provider.Credits{}
+	for _, edge := range data.Media.Characters.Edges {
+		for _, actor := range edge.VoiceActors {
+			credits.Cast = append(credits.Cast, &pb.CastMember{
+				Person:    actor.summary(),
+				Character: edge.Node.Name.Full,
+				Order:     int32(len(credits.Cast)),
+			})
+		}
+	}
+	for _, edge := range data.Media.Staff.Edges {
+		credits.Crew = append(credits.Crew, &pb.CrewMember{
+			Person: edge.Node.summary(),
+			Job:    edge.Role,
+		})
+	}
+
+	log.Printf("Получен состав тайтла %d из AniList: %d актёров, %d в съёмочной группе",
+		id, len(credits.Cast), len(credits.Crew))
+	return credits, nil
+}
+
+func (p *Provider) Characters(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.CharacterPage, error) {
+	var data struct {
+		Media struct {
+			Characters struct {
+				PageInfo pageInfo `json:"pageInfo"`
+				Edges    []struct {
+					Role string `json:"role"`
+					Node struct {
+						characterName
+						ID    int64 `json:"id"`
+						Image struct {
+							Large string `json:"large"`
+						} `json:"image"`
+					} `json:"node"`
+					VoiceActors []staff `json:"voiceActors"`
+				} `json:"edges"`
+			} `json:"characters"`
+		} `json:"Media"`
+	}
+	if err := p.query(ctx, charactersQuery, map[string]any{
+		"id":      id,
+		"page":    max(page, 1),
+		"perPage": perPage,
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	characters := data.Media.Characters
+	result := &provider.CharacterPage{
+		Page:         characters.PageInfo.CurrentPage,
+		TotalPages:   characters.PageInfo.LastPage,
+		TotalResults: characters.PageInfo.Total,
+	}
+	for _, edge := range characters.Edges {
+		character := &pb.Character{
+			Id:         edge.Node.ID,
+			Name:       edge.Node.Name.Full,
+			NativeName: edge.Node.Name.Native,
+			Image:      provider.NewImage(pb.ImageKind_IMAGE_KIND_PROFILE, edge.Node.Image.Large),
+			Role:       characterRole(edge.Role),
+		}
+		for _, actor := range edge.VoiceActors {
+			character.VoiceActors = append(character.VoiceActors, &pb.VoiceActor{
+				Person:   actor.summary(),
+				Language: languageCode(actor.LanguageV2),
+			})
+		}
+		sortVoiceActors(character.VoiceActors, language)
+		result.Results = append(result.Results, character)
+	}
+	return result, nil
+}
+
+func (p *Provider) Person(ctx context.Context, id int64, language string) (*pb.PersonDetails, error) {
+	type animeNode struct {
+		media
+		Type string `json:"type"`
+	}
+	var data struct {
+		Staff struct {
+			staff
+			Description    string    `json:"description"`
+			DateOfBirth    fuzzyDate `json:"dateOfBirth"`
+			DateOfDeath    fuzzyDate `json:"dateOfDeath"`
+			HomeTown       string    `json:"homeTown"`
+			CharacterMedia struct {
+				Edges []struct {
+					Characters []characterName `json:"characters"`
+					Node       animeNode       `json:"node"`
+				} `json:"edges"`
+			} `json:"characterMedia"`
+			StaffMedia struct {
+				Edges []struct {
+					StaffRole string    `json:"staffRole"`
+					Node      animeNode `json:"node"`
+				} `json:"edges"`
+			} `json:"staffMedia"`
+		} `json:"Staff"`
+	}
+	if err := p.query(ctx, personQuery, map[string]any{"id": id, "perPage": maxCredits}, &data); err != nil {
+		return nil, err
+	}
+
+	s := data.Staff
+	log.Printf("Получен человек из AniList: %s", s.Name.Full)
+
+	summary := s.summary()
+	person := &pb.PersonDetails{
+		Id:                 summary.GetId(),
+		Name:               summary.GetName(),
+		NativeName:         s.Name.Native,
+		Biography:          plainText(s.Description),
+		Birthday:           s.DateOfBirth.String(),
+		Deathday:           s.DateOfDeath.String(),
+		PlaceOfBirth:       s.HomeTown,
+		Profile:            summary.GetProfile(),
+		KnownForDepartment: summary.GetKnownForDepartment(),
+		Popularity:         summary.GetPopularity(),
+	}
+	for _, edge := range s.CharacterMedia.Edges {
+		if edge.Node.Type != "ANIME" || edge.Node.IsAdult {
+			continue
+		}
+		names := make([]string, len(edge.Characters))
+		for i, c := range edge.Characters {
+			names[i] = c.Name.Full
+		}
+		person.Filmography = append(person.Filmography, &pb.FilmographyCredit{
+			Title:      edge.Node.toMovie(language),
+			Character:  strings.Join(names, " / "),
+			Department: "Acting",
+		})
+	}
+	for _, edge := range s.StaffMedia.Edges {
+		if edge.Node.IsAdult {
+			continue
+		}
+		person.Filmography = append(person.Filmography, &pb.FilmographyCredit{
+			Title: edge.Node.toMovie(language),
+			Job:   edge.StaffRole,
+		})
+	}
+	provider.SortFilmography(person.Filmography)
+	return person, nil
+}
+
+func characterRole(role string) pb.CharacterRole {
+	switch role {
+	case "MAIN":
+		return pb.CharacterRole_CHARACTER_ROLE_MAIN
+	case "SUPPORTING":
+		return pb.CharacterRole_CHARACTER_ROLE_SUPPORTING
+	case "BACKGROUND":
+		return pb.CharacterRole_CHARACTER_ROLE_BACKGROUND
+	default:
+		return pb.CharacterRole_CHARACTER_ROLE_UNSPECIFIED
+	}
+}
+
+// languageCodes переводит названия языков AniList (Staff.languageV2) в
+// коды ISO 639-1.
+var languageCodes = map[string]string{
+	"Japanese":   "ja",
+	"English":    "en",
+	"Korean":     "ko",
+	"Chinese":    "zh",
+	"Russian":    "ru",
+	"German":     "de",
+	"French":     "fr",
+	"Spanish":    "es",
+	"Italian":    "it",
+	"Portuguese": "pt",
+	"Hungarian":  "hu",
+	"Hebrew":     "he",
+	"Arabic":     "ar",
+	"Filipino":   "tl",
+	"Catalan":    "ca",
+	"Finnish":    "fi",
+	"Turkish":    "tr",
+	"Dutch":      "nl",
+	"Swedish":    "sv",
+	"Thai":       "th",
+	"Tagalog":    "tl",
+	"Malaysian":  "ms",
+	"Indonesian": "id",
+	"Vietnamese": "vi",
+	"Polish":     "pl",
+	"Norwegian":  "no",
+	"Danish":     "da",
+	"Greek":      "el",
+	"Czech":      "cs",
+}
+
+// languageCode возвращает код ISO 639-1 языка или название в нижнем
+// регистре, если код неизвестен.
+func languageCode(name string) string {
+	if code, ok := languageCodes[name]; ok {
+		return code
+	}
+	return strings.ToLower(name)
+}
+
+// sortVoiceActors ставит первыми японских сэйю, за ними — актёров
+// дубляжа на языке запроса; порядок остальных сохраняется.
+func sortVoiceActors(actors []*pb.VoiceActor, language string) {
+	localized, _, _ := strings.Cut(language, "-")
+	rank := func(a *pb.VoiceActor) int {
+		switch a.GetLanguage() {
+		case "ja":
+			return 0
+		case localized:
+			return 1
+		default:
+			return 2
+		}
+	}
+	slices.SortStableFunc(actors, func(a, b *pb.VoiceActor) int {
+		return rank(a) - rank(b)
+	})
+}
@@ -0,0 +1,304 @@
+package anilist
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// Форматы AniList, которые считаются фильмами и сериалами.
+var (
+	movieFormats = []string{"MOVIE"}
+	tvFormats    = []string{"TV", "TV_SHORT", "ONA", "OVA", "SPECIAL"}
+)
+
+const mediaFields = `
+fragment mediaFields on Media {
+  id
+  idMal
+  format
+  status
+  episodes
+  duration
+  averageScore
+  popularity
+  isAdult
+  countryOfOrigin
+  title { romaji english native }
+  description(asHtml: false)
+  startDate { year month day }
+  endDate { year month day }
+  coverImage { extraLarge large }
+  bannerImage
+  genres
+  synonyms
+  nextAiringEpisode { airingAt episode }
+}`
+
+const pageQuery = `
+query ($page: Int, $perPage: Int, $search: String, $sort: [MediaSort], $format: [MediaFormat], $country: CountryCode,
+       $season: MediaSeason, $seasonYear: Int, $startFrom: FuzzyDateInt, $startTo: FuzzyDateInt,
+       $scoreFrom: Int, $popularityFrom: Int, $durationFrom: Int, $durationTo: Int) {
+  Page(page: $page, perPage: $perPage) {
+    pageInfo { total currentPage lastPage }
+    media(type: ANIME, isAdult: false, search: $search, sort: $sort, format_in: $format, countryOfOrigin: $country,
+          season: $season, seasonYear: $seasonYear, startDate_greater: $startFrom, startDate_lesser: $startTo,
+          averageScore_greater: $scoreFrom, popularity_greater: $popularityFrom,
+          duration_greater: $durationFrom, duration_lesser: $durationTo) {
+      ...mediaFields
+    }
+  }
+}` + mediaFields
+
+const mediaByIDQuery = `
+query ($id: Int) {
+  Media(id: $id, type: ANIME) {
+    ...mediaFields
+  }
+}` + mediaFields
+
+const airingScheduleQuery = `
+query ($page: Int, $perPage: Int, $from: Int, $to: Int) {
+  Page(page: $page, perPage: $perPage) {
+    pageInfo { total currentPage lastPage }
+    airingSchedules(airingAt_greater: $from, airingAt_lesser: $to, sort: TIME) {
+      episode
+      airingAt
+      media { ...mediaFields }
+    }
+  }
+}` + mediaFields
+
+const staffSearchQuery = `
+query ($page: Int, $perPage: Int, $search: String) {
+  Page(page: $page, perPage: $perPage) {
+    pageInfo { total currentPage lastPage }
+    staff(search: $search, sort: SEARCH_MATCH) {
+      id
+      name { full native }
+      image { large }
+      primaryOccupations
+      favourites
+      staffMedia(type: ANIME, sort: POPULARITY_DESC, perPage: 3) {
+        nodes { ...mediaFields }
+      }
+    }
+  }
+}` + mediaFields
+
+type pageInfo struct {
+	Total       int32 `json:"total"`
+	CurrentPage int32 `json:"currentPage"`
+	LastPage    int32 `json:"lastPage"`
+}
+
+type fuzzyDate struct {
+	Year  int `json:"year"`
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+// String возвращает дату в формате TMDb (YYYY-MM-DD) или пустую строку,
+// если год неизвестен. Неизвестные месяц и день заменяются единицами.
+func (d fuzzyDate) String() string {
+	if d.Year == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, max(d.Month, 1), max(d.Day, 1))
+}
+
+// fuzzyDateInt переводит дату YYYY-MM-DD в формат FuzzyDateInt (YYYYMMDD);
+// пустая или некорректная дата даёт 0.
+func fuzzyDateInt(date string) int {
+	n, err := strconv.Atoi(strings.ReplaceAll(date, "-", ""))
+	if err != nil || len(date) != len("2006-01-02") {
+		return 0
+	}
+	return n
+}
+
+type media struct {
+	ID              int64  `json:"id"`
+	IDMal           int64  `json:"idMal"`
+	Format          string `json:"format"`
+	Status          string `json:"status"`
+	Episodes        int32  `json:"episodes"`
+	Duration        int32  `json:"duration"`
+	AverageScore    int32  `json:"averageScore"`
+	Popularity      int32  `json:"popularity"`
+	IsAdult         bool   `json:"isAdult"`
+	CountryOfOrigin string `json:"countryOfOrigin"`
+	Title           struct {
+		Romaji  string `json:"romaji"`
+		English string `json:"english"`
+		Native  string `json:"native"`
+	} `json:"title"`
+	Description string    `json:"description"`
+	StartDate   fuzzyDate `json:"startDate"`
+	EndDate     fuzzyDate `json:"endDate"`
+	CoverImage  struct {
+		ExtraLarge string `json:"extraLarge"`
+		Large      string `json:"large"`
+	} `json:"coverImage"`
+	BannerImage       string          `json:"bannerImage"`
+	Genres            []string        `json:"genres"`
+	Synonyms          []string        `json:"synonyms"`
+	NextAiringEpisode *airingSchedule `json:"nextAiringEpisode"`
+}
+
+type airingSchedule struct {
+	Episode  int32 `json:"episode"`
+	AiringAt int64 `json:"airingAt"`
+	Media    media `json:"media"`
+}
+
+// toEpisode переводит выход эпизода тайтла m в AiringEpisode. Время
+// выхода в часовом поясе клиента (airs_at) заполняет сервер.
+func (a airingSchedule) toEpisode(m media, language string) *pb.AiringEpisode {
+	return &pb.AiringEpisode{
+		Title:         m.toMovie(language),
+		EpisodeNumber: a.Episode,
+		AirsAtUnix:    a.AiringAt,
+		Runtime:       m.Duration,
+	}
+}
+
+type staff struct {
+	ID   int64 `json:"id"`
+	Name struct {
+		Full   string `json:"full"`
+		Native string `json:"native"`
+	} `json:"name"`
+	Image struct {
+		Large string `json:"large"`
+	} `json:"image"`
+	PrimaryOccupations []string `json:"primaryOccupations"`
+	Favourites         int32    `json:"favourites"`
+	LanguageV2         string   `json:"languageV2"`
+	StaffMedia         struct {
+		Nodes []media `json:"nodes"`
+	} `json:"staffMedia"`
+}
+
+// summary возвращает краткую карточку без known_for.
+func (s staff) summary() *pb.PersonSummary {
+	return &pb.PersonSummary{
+		Id:                 s.ID,
+		Name:               s.Name.Full,
+		Profile:            provider.NewImage(pb.ImageKind_IMAGE_KIND_PROFILE, s.Image.Large),
+		KnownForDepartment: strings.Join(s.PrimaryOccupations, ", "),
+		Popularity:         float64(s.Favourites),
+	}
+}
+
+func (m media) mediaType() pb.MediaType {
+	if m.Format == "MOVIE" {
+		return pb.MediaType_MEDIA_TYPE_MOVIE
+	}
+	return pb.MediaType_MEDIA_TYPE_TV
+}
+
+// title выбирает название для языка language: английское для "en-*",
+// иначе ромадзи.
+func (m media) title(language string) string {
+	if strings.HasPrefix(language, "en") && m.Title.English != "" {
+		return m.Title.English
+	}
+	if m.Title.Romaji != "" {
+		return m.Title.Romaji
+	}
+	return m.Title.English
+}
+
+// alternativeTitles возвращает все названия тайтла, кроме основного для
+// языка language и оригинального: ромадзи, английское и синонимы.
+func (m media) alternativeTitles(language string) []string {
+	skip := []string{m.title(language), m.Title.Native}
+	var titles []string
+	for _, t := range append([]string{m.Title.Romaji, m.Title.English}, m.Synonyms...) {
+		if t != "" && !slices.Contains(skip, t) && !slices.Contains(titles, t) {
+			titles = append(titles, t)
+		}
+	}
+	return titles
+}
+
+// voteAverage переводит оценку AniList (0–100) в шкалу TMDb (0–10).
+func (m media) voteAverage() float64 {
+	return float64(m.AverageScore) / 10
+}
+
+func (m media) toMovie(language string) *pb.Movie {
+	return &pb.Movie{
+		Id:                m.ID,
+		Title:             m.title(language),
+		OriginalTitle:     m.Title.Native,
+		Overview:          plainText(m.Description),
+		Poster:            provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, m.CoverImage.ExtraLarge),
+		Backdrop:          provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, m.BannerImage),
+		ReleaseDate:       m.StartDate.String(),
+		VoteAverage:       m.voteAverage(),
+		MediaType:         m.mediaType(),
+		Popularity:        float64(m.Popularity),
+		AlternativeTitles: m.alternativeTitles(language),
+	}
+}
+
+func (m media) toDetails(language string) *pb.TitleDetails {
+	details := &pb.TitleDetails{
+		Id:                m.ID,
+		MediaType:         m.mediaType(),
+		Title:             m.title(language),
+		OriginalTitle:     m.Title.Native,
+		Overview:          plainText(m.Description),
+		Poster:            provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, m.CoverImage.ExtraLarge),
+		Backdrop:          provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, m.BannerImage),
+		ReleaseDate:       m.StartDate.String(),
+		Status:            m.Status,
+		Runtime:           m.Duration,
+		OriginalLanguage:  originalLanguage(m.CountryOfOrigin),
+		Adult:             m.IsAdult,
+		VoteAverage:       m.voteAverage(),
+		Popularity:        float64(m.Popularity),
+		AlternativeTitles: m.alternativeTitles(language),
+	}
+	details.ExternalIds = map[string]string{"anilist": strconv.FormatInt(m.ID, 10)}
+	if m.IDMal != 0 {
+		details.ExternalIds["mal"] = strconv.FormatInt(m.IDMal, 10)
+	}
+	if m.CountryOfOrigin != "" {
+		details.OriginCountry = []string{m.CountryOfOrigin}
+	}
+	for _, g := range m.Genres {
+		details.Genres = append(details.Genres, &pb.Genre{Name: g})
+	}
+	if details.MediaType == pb.MediaType_MEDIA_TYPE_TV {
+		if m.NextAiringEpisode != nil {
+			details.NextEpisode = m.NextAiringEpisode.toEpisode(m, language)
+		}
+		details.LastAirDate = m.EndDate.String()
+		details.NumberOfEpisodes = m.Episodes
+		if m.Episodes > 0 {
+			details.NumberOfSeasons = 1
+		}
+	}
+	return details
+}
+
+// originalLanguage угадывает язык оригинала по стране: AniList его не отдаёт.
+func originalLanguage(country string) string {
+	switch country {
+	case "JP":
+		return "ja"
+	case "KR":
+		return "ko"
+	case "CN", "TW":
+		return "zh"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,92 @@
+package anilist
+
+import (
+	"context"
+	"log"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+const relationsQuery = `
+query ($id: Int) {
+  Media(id: $id, type: ANIME) {
+    ...mediaFields
+    relations {
+      edges {
+        relationType(version: 2)
+        node { type ...mediaFields }
+      }
+    }
+  }
+}` + mediaFields
+
+// Relations возвращает связи аниме с другими аниме; связи с мангой и
+// ранобэ (первоисточники) пропускаются. ID AniList сквозные, поэтому
+// mediaType не используется.
+func (p *Provider) Relations(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.TitleRelations, error) {
+	var data struct {
+		Media struct {
+			media
+			Relations struct {
+				Edges []struct {
+					RelationType string `json:"relationType"`
+					Node         struct {
+						media
+						Type string `json:"type"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"relations"`
+		} `json:"Media"`
+	}
+	if err := p.query(ctx, relationsQuery, map[string]any{"id": id}, &data); err != nil {
+		return nil, err
+	}
+
+	result := &provider.TitleRelations{
+		Title:  data.Media.toMovie(language),
+		Format: data.Media.format(),
+	}
+	for _, edge := range data.Media.Relations.Edges {
+		if edge.Node.Type != "ANIME" || edge.Node.IsAdult {
+			continue
+		}
+		result.Relations = append(result.Relations, &pb.Relation{
+			Type:   relationType(edge.RelationType, edge.Node.Format),
+			Title:  edge.Node.toMovie(language),
+			Format: edge.Node.format(),
+		})
+	}
+
+	log.Printf("Получено %d связей аниме %d из AniList", len(result.Relations), id)
+	return result, nil
+}
+
+// relationType переводит тип связи AniList в RelationType. Пересказы и
+// альтернативные версии в формате фильма считаются фильмами-пересказами.
+func relationType(relation, format string) pb.RelationType {
+	switch relation {
+	case "SEQUEL":
+		return pb.RelationType_RELATION_TYPE_SEQUEL
+	case "PREQUEL":
+		return pb.RelationType_RELATION_TYPE_PREQUEL
+	case "PARENT":
+		return pb.RelationType_RELATION_TYPE_PARENT
+	case "SIDE_STORY":
+		return pb.RelationType_RELATION_TYPE_SIDE_STORY
+	case "SPIN_OFF":
+		return pb.RelationType_RELATION_TYPE_SPIN_OFF
+	case "CHARACTER":
+		return pb.RelationType_RELATION_TYPE_CHARACTER
+	case "SUMMARY", "COMPILATION", "ALTERNATIVE":
+		if format == "MOVIE" {
+			return pb.RelationType_RELATION_TYPE_MOVIE_ADAPTATION
+		}
+		if relation == "ALTERNATIVE" {
+			return pb.RelationType_RELATION_TYPE_ALTERNATIVE
+		}
+		return pb.RelationType_RELATION_TYPE_SUMMARY
+	default:
+		return pb.RelationType_RELATION_TYPE_OTHER
+	}
+}
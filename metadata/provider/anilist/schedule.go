@@ -0,0 +1,42 @@
+package anilist
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+func (p *Provider) AiringSchedule(ctx context.Context, from, to time.Time, page int32, language string) (*provider.SchedulePage, error) {
+	var data struct {
+		Page struct {
+			PageInfo        pageInfo         `json:"pageInfo"`
+			AiringSchedules []airingSchedule `json:"airingSchedules"`
+		} `json:"Page"`
+	}
+	// Границы airingAt_greater и airingAt_lesser строгие.
+	if err := p.query(ctx, airingScheduleQuery, map[string]any{
+		"page":    max(page, 1),
+		"perPage": perPage,
+		"from":    from.Unix() - 1,
+		"to":      to.Unix(),
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получено %d эпизодов расписания из AniList", len(data.Page.AiringSchedules))
+
+	result := &provider.SchedulePage{
+		Page:         data.Page.PageInfo.CurrentPage,
+		TotalPages:   data.Page.PageInfo.LastPage,
+		TotalResults: data.Page.PageInfo.Total,
+	}
+	for _, a := range data.Page.AiringSchedules {
+		if a.Media.IsAdult {
+			continue
+		}
+		result.Results = append(result.Results, a.toEpisode(a.Media, language))
+	}
+	return result, nil
+}
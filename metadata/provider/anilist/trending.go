@@ -0,0 +1,84 @@
+package anilist
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+const recommendationsQuery = `
+query ($id: Int, $page: Int, $perPage: Int) {
+  Media(id: $id, type: ANIME) {
+    recommendations(page: $page, perPage: $perPage, sort: [RATING_DESC, ID]) {
+      pageInfo { total currentPage lastPage }
+      nodes {
+        mediaRecommendation { ...mediaFields }
+      }
+    }
+  }
+}` + mediaFields
+
+// Trending сортирует по тренду AniList — росту активности пользователей
+// за последние дни. Выбрать период AniList не позволяет, поэтому window
+// не учитывается.
+func (p *Provider) Trending(ctx context.Context, mediaType pb.MediaType, window pb.TimeWindow, page int32, language string) (*provider.MoviePage, error) {
+	vars := pageVariables{
+		Page: page,
+		Sort: []string{"TRENDING_DESC", "POPULARITY_DESC"},
+	}
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		vars.Format = movieFormats
+	case pb.MediaType_MEDIA_TYPE_TV:
+		vars.Format = tvFormats
+	case pb.MediaType_MEDIA_TYPE_UNSPECIFIED:
+	default:
+		return nil, provider.Unsupported(p.Name(), fmt.Sprintf("тренды для типа %s", mediaType))
+	}
+	return p.mediaPage(ctx, language, vars)
+}
+
+// Recommendations возвращает рекомендации пользователей AniList. Они не
+// делятся на фильмы и сериалы, поэтому mediaType не используется.
+func (p *Provider) Recommendations(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	var data struct {
+		Media struct {
+			Recommendations struct {
+				PageInfo pageInfo `json:"pageInfo"`
+				Nodes    []struct {
+					MediaRecommendation *media `json:"mediaRecommendation"`
+				} `json:"nodes"`
+			} `json:"recommendations"`
+		} `json:"Media"`
+	}
+	if err := p.query(ctx, recommendationsQuery, map[string]any{
+		"id":      id,
+		"page":    max(page, 1),
+		"perPage": perPage,
+	}, &data); err != nil {
+		return nil, err
+	}
+
+	recommendations := data.Media.Recommendations
+	log.Printf("Получено %d рекомендаций из AniList", len(recommendations.Nodes))
+
+	result := &provider.MoviePage{
+		Page:         recommendations.PageInfo.CurrentPage,
+		TotalPages:   recommendations.PageInfo.LastPage,
+		TotalResults: recommendations.PageInfo.Total,
+	}
+	for _, node := range recommendations.Nodes {
+		// Рекомендованный тайтл может быть удалён из базы.
+		if m := node.MediaRecommendation; m != nil && !m.IsAdult {
+			result.Results = append(result.Results, m.toMovie(language))
+		}
+	}
+	return result, nil
+}
+
+func (p *Provider) Similar(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	return nil, provider.Unsupported(p.Name(), "поиск похожих тайтлов")
+}
@@ -0,0 +1,55 @@
+package anilist
+
+import (
+	"context"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+const trailerQuery = `
+query ($id: Int) {
+  Media(id: $id, type: ANIME) {
+    trailer { id site }
+  }
+}`
+
+// hostingNames переводит хостинги AniList в названия, которые использует
+// TMDb.
+var hostingNames = map[string]string{
+	"youtube":     "YouTube",
+	"dailymotion": "Dailymotion",
+}
+
+// Videos возвращает трейлер тайтла. AniList хранит один трейлер без
+// языка, поэтому он отдаётся для любого language.
+func (p *Provider) Videos(ctx context.Context, mediaType pb.MediaType, id int64, language string) ([]*pb.Video, error) {
+	var data struct {
+		Media struct {
+			Trailer *struct {
+				ID   string `json:"id"`
+				Site string `json:"site"`
+			} `json:"trailer"`
+		} `json:"Media"`
+	}
+	if err := p.query(ctx, trailerQuery, map[string]any{"id": id}, &data); err != nil {
+		return nil, err
+	}
+
+	trailer := data.Media.Trailer
+	if trailer == nil || trailer.ID == "" {
+		return nil, nil
+	}
+	site := hostingNames[trailer.Site]
+	if site == "" {
+		site = trailer.Site
+	}
+	return []*pb.Video{{
+		Id:   trailer.ID,
+		Name: "Trailer",
+		Type: pb.VideoType_VIDEO_TYPE_TRAILER,
+		Site: site,
+		Key:  trailer.ID,
+		Url:  provider.VideoURL(site, trailer.ID),
+	}}, nil
+}
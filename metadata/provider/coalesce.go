@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+	"sync/atomic"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// UpstreamStats — счётчики обращений к внешнему API.
+type UpstreamStats struct {
+	// Calls — сколько раз провайдеру понадобились данные из API.
+	Calls uint64
+	// Requests — сколько запросов реально ушло в API.
+	Requests uint64
+	// Coalesced — сколько вызовов получили результат чужого запроса.
+	Coalesced uint64
+}
+
+// StatsReporter реализуют провайдеры, которые ведут UpstreamStats.
+type StatsReporter interface {
+	UpstreamStats() UpstreamStats
+}
+
+// Coalescer объединяет одновременные одинаковые запросы к внешнему API:
+// пока запрос с ключом key выполняется, остальные вызовы с тем же ключом
+// ждут его результат вместо того, чтобы отправлять свой.
+type Coalescer struct {
+	group singleflight.Group
+
+	calls    atomic.Uint64
+	requests atomic.Uint64
+}
+
+// Do возвращает результат fetch для key. Тело ответа общее для всех
+// ожидающих, поэтому вызывающий код не должен его изменять.
+//
+// fetch получает контекст первого вызова без отмены, но с его дедлайном:
+// отмена ctx прерывает ожидание только текущего вызова и не обрывает
+// запрос, результата которого ждут другие.
+func (c *Coalescer) Do(ctx context.Context, key string, fetch func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	c.calls.Add(1)
+
+	ch := c.group.DoChan(key, func() (any, error) {
+		c.requests.Add(1)
+
+		fetchCtx := context.WithoutCancel(ctx)
+		if deadline, ok := ctx.Deadline(); ok {
+			var cancel context.CancelFunc
+			fetchCtx, cancel = context.WithDeadline(fetchCtx, deadline)
+			defer cancel()
+		}
+		return fetch(fetchCtx)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]byte), nil
+	}
+}
+
+func (c *Coalescer) Stats() UpstreamStats {
+	calls, requests := c.calls.Load(), c.requests.Load()
+
+	stats := UpstreamStats{Calls: calls, Requests: requests}
+	if calls > requests {
+		stats.Coalesced = calls - requests
+	}
+	return stats
+}
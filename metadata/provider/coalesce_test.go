@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitCalls ждёт, пока в Do войдут n вызовов.
+func waitCalls(t *testing.T, c *Coalescer, n uint64) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for c.Stats().Calls < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("в Do вошло %d вызовов, want %d", c.Stats().Calls, n)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// Вызов считается до того, как встанет в очередь за результатом.
+	time.Sleep(10 * time.Millisecond)
+}
+
+func TestCoalescerDo(t *testing.T) {
+	const callers = 5
+
+	var c Coalescer
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) ([]byte, error) {
+		fetches.Add(1)
+		<-release
+		return []byte("ответ"), nil
+	}
+
+	var wg sync.WaitGroup
+	for range callers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body, err := c.Do(context.Background(), "movie/1", fetch)
+			if err != nil || string(body) != "ответ" {
+				t.Errorf("Do = %q, %v", body, err)
+			}
+		}()
+	}
+	waitCalls(t, &c, callers)
+	close(release)
+	wg.Wait()
+
+	if got := fetches.Load(); got != 1 {
+		t.Errorf("запросов к API = %d, want 1", got)
+	}
+	want := UpstreamStats{Calls: callers, Requests: 1, Coalesced: callers - 1}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+
+	// Завершённый запрос не переиспользуется, разные ключи не объединяются.
+	for _, key := range []string{"movie/1", "movie/2"} {
+		if _, err := c.Do(context.Background(), key, fetch); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := fetches.Load(); got != 3 {
+		t.Errorf("запросов к API = %d, want 3", got)
+	}
+	want = UpstreamStats{Calls: callers + 2, Requests: 3, Coalesced: callers - 1}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats = %+v, want %+v", got, want)
+	}
+}
+
+func TestCoalescerSharesError(t *testing.T) {
+	var c Coalescer
+	wantErr := errors.New("API недоступен")
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) ([]byte, error) {
+		<-release
+		return nil, wantErr
+	}
+
+	errs := make(chan error, 2)
+	for range 2 {
+		go func() {
+			_, err := c.Do(context.Background(), "movie/1", fetch)
+			errs <- err
+		}()
+	}
+	waitCalls(t, &c, 2)
+	close(release)
+	for range 2 {
+		if err := <-errs; !errors.Is(err, wantErr) {
+			t.Errorf("ошибка = %v, want %v", err, wantErr)
+		}
+	}
+	if got := c.Stats().Requests; got != 1 {
+		t.Errorf("Requests = %d, want 1", got)
+	}
+}
+
+func TestCoalescerCancel(t *testing.T) {
+	var c Coalescer
+	release := make(chan struct{})
+	fetchErr := make(chan error, 1)
+	fetch := func(ctx context.Context) ([]byte, error) {
+		<-release
+		fetchErr <- ctx.Err()
+		return []byte("ответ"), nil
+	}
+
+	// Первый вызов запускает запрос и отменяется, пока второй ждёт.
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	first := make(chan error, 1)
+	go func() {
+		_, err := c.Do(firstCtx, "movie/1", fetch)
+		first <- err
+	}()
+	waitCalls(t, &c, 1)
+
+	second := make(chan []byte, 1)
+	go func() {
+		body, err := c.Do(context.Background(), "movie/1", fetch)
+		if err != nil {
+			t.Errorf("второй вызов: %v", err)
+		}
+		second <- body
+	}()
+	waitCalls(t, &c, 2)
+
+	cancelFirst()
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("первый вызов: %v, want context.Canceled", err)
+	}
+
+	close(release)
+	if err := <-fetchErr; err != nil {
+		t.Errorf("запрос оборван отменой первого вызова: %v", err)
+	}
+	if body := <-second; string(body) != "ответ" {
+		t.Errorf("второй вызов = %q, want %q", body, "ответ")
+	}
+	if got := c.Stats().Requests; got != 1 {
+		t.Errorf("Requests = %d, want 1", got)
+	}
+}
+
+func TestCoalescerKeepsDeadline(t *testing.T) {
+	var c Coalescer
+	deadline := time.Now().Add(time.Minute)
+	ctx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	_, err := c.Do(ctx, "movie/1", func(ctx context.Context) ([]byte, error) {
+		if got, ok := ctx.Deadline(); !ok || !got.Equal(deadline) {
+			t.Errorf("дедлайн запроса = %v, %v, want %v", got, ok, deadline)
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+)
+
+// ErrorKind классифицирует ошибки внешнего API.
+type ErrorKind int
+
+const (
+	// KindUnavailable — API недоступен или ответил 5xx.
+	KindUnavailable ErrorKind = iota
+	// KindNotFound — запрошенной записи нет у источника.
+	KindNotFound
+	// KindUnauthorized — источник отклонил ключ API.
+	KindUnauthorized
+	// KindRateLimited — превышен лимит запросов к источнику.
+	KindRateLimited
+	// KindTimeout — источник не ответил вовремя.
+	KindTimeout
+	// KindMalformed — ответ источника не удалось разобрать.
+	KindMalformed
+	// KindUnsupported — источник не умеет выполнять такой запрос.
+	KindUnsupported
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case KindNotFound:
+		return "UPSTREAM_NOT_FOUND"
+	case KindUnauthorized:
+		return "UPSTREAM_UNAUTHORIZED"
+	case KindRateLimited:
+		return "UPSTREAM_RATE_LIMITED"
+	case KindTimeout:
+		return "UPSTREAM_TIMEOUT"
+	case KindMalformed:
+		return "UPSTREAM_MALFORMED_RESPONSE"
+	case KindUnsupported:
+		return "UPSTREAM_UNSUPPORTED"
+	default:
+		return "UPSTREAM_UNAVAILABLE"
+	}
+}
+
+// Error — ошибка обращения к источнику метаданных.
+type Error struct {
+	Kind     ErrorKind
+	Provider string
+	// RetryAfter — через сколько источник разрешил повторить запрос
+	// (только для KindRateLimited).
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %v", e.Provider, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NewError создаёт ошибку источника заданного вида.
+func NewError(providerName string, kind ErrorKind, err error) *Error {
+	return &Error{Kind: kind, Provider: providerName, Err: err}
+}
+
+// Unsupported возвращает ошибку KindUnsupported для операции what.
+func Unsupported(providerName, what string) *Error {
+	return NewError(providerName, KindUnsupported, fmt.Errorf("%s не поддерживается", what))
+}
+
+// Classify превращает ошибку HTTP-клиента или декодера в *Error.
+// Уже классифицированные ошибки и отмена запроса клиентом не меняются.
+func Classify(providerName string, err error) error {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	var providerErr *Error
+	if errors.As(err, &providerErr) {
+		return err
+	}
+
+	e := NewError(providerName, KindUnavailable, err)
+
+	var statusErr *httpclient.StatusError
+	var netErr net.Error
+	switch {
+	case errors.As(err, &statusErr):
+		switch statusErr.StatusCode {
+		case http.StatusNotFound:
+			e.Kind = KindNotFound
+		case http.StatusUnauthorized, http.StatusForbidden:
+			e.Kind = KindUnauthorized
+		case http.StatusTooManyRequests:
+			e.Kind = KindRateLimited
+			e.RetryAfter = statusErr.RetryAfter
+		case http.StatusGatewayTimeout:
+			e.Kind = KindTimeout
+		}
+	case errors.Is(err, context.DeadlineExceeded),
+		errors.As(err, &netErr) && netErr.Timeout():
+		e.Kind = KindTimeout
+	}
+	return e
+}
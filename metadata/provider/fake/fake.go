@@ -0,0 +1,438 @@
+package fake
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+const pageSize = 20
+
+// Provider — локальный источник метаданных с фиксированным каталогом.
+// Не ходит в сеть, поэтому подходит для разработки фронтенда и отладки
+// без ключа TMDb.
+type Provider struct {
+	movies  []*pb.Movie
+	tvShows []*pb.Movie
+	people  []*pb.PersonSummary
+}
+
+var _ provider.Provider = (*Provider)(nil)
+
+func New() *Provider {
+	p := &Provider{
+		movies: []*pb.Movie{
+			{Id: 129, Title: "Унесённые призраками", OriginalTitle: "千と千尋の神隠し", AlternativeTitles: []string{"Spirited Away", "Sen to Chihiro no Kamikakushi"}, Overview: "Девочка Тихиро попадает в мир духов.", ReleaseDate: "2001-07-20", VoteAverage: 8.5, MediaType: pb.MediaType_MEDIA_TYPE_MOVIE},
+			{Id: 128, Title: "Принцесса Мононоке", OriginalTitle: "もののけ姫", Overview: "Принц Аситака ищет лекарство от проклятия.", ReleaseDate: "1997-07-12", VoteAverage: 8.3, MediaType: pb.MediaType_MEDIA_TYPE_MOVIE},
+			{Id: 372058, Title: "Твоё имя", OriginalTitle: "君の名は。", Overview: "Двое подростков обнаруживают, что меняются телами.", ReleaseDate: "2016-08-26", VoteAverage: 8.5, MediaType: pb.MediaType_MEDIA_TYPE_MOVIE},
+			{Id: 8392, Title: "Мой сосед Тоторо", OriginalTitle: "となりのトトロ", Overview: "Сёстры Сацуки и Мэй знакомятся с лесными духами.", ReleaseDate: "1988-04-16", VoteAverage: 8.1, MediaType: pb.MediaType_MEDIA_TYPE_MOVIE},
+		},
+		tvShows: []*pb.Movie{
+			{Id: 1429, Title: "Атака титанов", OriginalTitle: "進撃の巨人", AlternativeTitles: []string{"Attack on Titan", "Shingeki no Kyojin"}, Overview: "Человечество живёт за стенами, защищаясь от титанов.", ReleaseDate: "2013-04-07", VoteAverage: 8.7, MediaType: pb.MediaType_MEDIA_TYPE_TV},
+			{Id: 31910, Title: "Наруто: Ураганные хроники", OriginalTitle: "NARUTO -ナルト- 疾風伝", Overview: "Наруто возвращается в Деревню Листа после тренировок.", ReleaseDate: "2007-02-15", VoteAverage: 8.5, MediaType: pb.MediaType_MEDIA_TYPE_TV},
+			{Id: 65930, Title: "Моя геройская академия", OriginalTitle: "僕のヒーローアカデミア", AlternativeTitles: []string{"My Hero Academia", "Boku no Hero Academia"}, Overview: "Мальчик без причуды мечтает стать героем.", ReleaseDate: "2016-04-03", VoteAverage: 8.6, MediaType: pb.MediaType_MEDIA_TYPE_TV},
+		},
+	}
+	p.people = []*pb.PersonSummary{
+		{Id: 608, Name: "Хаяо Миядзаки", KnownForDepartment: "Directing", Popularity: 12.5, KnownFor: p.movies[:2]},
+		{Id: 1056121, Name: "Макото Синкай", KnownForDepartment: "Directing", Popularity: 8.1, KnownFor: p.movies[2:3]},
+	}
+	return p
+}
+
+func (p *Provider) Name() string {
+	return "fake"
+}
+
+func (p *Provider) PopularMovies(ctx context.Context, page int32, language string) (*provider.MoviePage, error) {
+	return paginate(p.movies, page), nil
+}
+
+func (p *Provider) SearchMovies(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	return paginate(filter(p.movies, query), page), nil
+}
+
+func (p *Provider) SearchTVShows(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	return paginate(filter(p.tvShows, query), page), nil
+}
+
+func (p *Provider) SearchPeople(ctx context.Context, query string, page int32, language string) (*provider.PersonPage, error) {
+	var people []*pb.PersonSummary
+	for _, person := range p.people {
+		if strings.Contains(strings.ToLower(person.GetName()), strings.ToLower(query)) {
+			people = append(people, person)
+		}
+	}
+
+	return &provider.PersonPage{
+		Results:      people,
+		Page:         1,
+		TotalPages:   1,
+		TotalResults: int32(len(people)),
+	}, nil
+}
+
+func (p *Provider) MovieByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	for _, m := range p.movies {
+		if m.GetId() == id {
+			return details(m), nil
+		}
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("фильм с ID %d не найден", id))
+}
+
+func (p *Provider) TVShowByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	for _, m := range p.tvShows {
+		if m.GetId() == id {
+			show := details(m)
+			show.Status = "Ended"
+			show.Runtime = 24
+			show.LastAirDate = m.GetReleaseDate()
+			show.NumberOfSeasons = 1
+			show.NumberOfEpisodes = episodesPerSeason
+			show.Seasons = []*pb.Season{season(m, false)}
+			return show, nil
+		}
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("сериал с ID %d не найден", id))
+}
+
+func (p *Provider) Season(ctx context.Context, tvID int64, seasonNumber int32, language string) (*pb.Season, error) {
+	for _, m := range p.tvShows {
+		if m.GetId() == tvID && seasonNumber == 1 {
+			return season(m, true), nil
+		}
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("сезон %d сериала %d не найден", seasonNumber, tvID))
+}
+
+func (p *Provider) Episode(ctx context.Context, tvID int64, seasonNumber, episodeNumber int32, language string) (*pb.Episode, error) {
+	s, err := p.Season(ctx, tvID, seasonNumber, language)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range s.GetEpisodes() {
+		if e.GetEpisodeNumber() == episodeNumber {
+			return e, nil
+		}
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("эпизод %d не найден", episodeNumber))
+}
+
+// details дополняет краткую карточку полями, общими для всего каталога:
+// все записи в нём — японская анимация.
+func details(m *pb.Movie) *pb.TitleDetails {
+	return &pb.TitleDetails{
+		Id:                m.GetId(),
+		MediaType:         m.GetMediaType(),
+		Title:             m.GetTitle(),
+		OriginalTitle:     m.GetOriginalTitle(),
+		Overview:          m.GetOverview(),
+		PosterPath:        m.GetPosterPath(),
+		AlternativeTitles: m.GetAlternativeTitles(),
+		Poster:            m.GetPoster(),
+		Backdrop:          m.GetBackdrop(),
+		ReleaseDate:       m.GetReleaseDate(),
+		Status:            "Released",
+		Genres:            []*pb.Genre{{Id: 16, Name: "мультфильм"}},
+		Runtime:           120,
+		OriginalLanguage:  "ja",
+		OriginCountry:     []string{"JP"},
+		VoteAverage:       m.GetVoteAverage(),
+	}
+}
+
+// У каждого сериала фиктивного каталога один сезон из episodesPerSeason эпизодов.
+const episodesPerSeason = 12
+
+func season(show *pb.Movie, withEpisodes bool) *pb.Season {
+	s := &pb.Season{
+		Id:           show.GetId()*100 + 1,
+		TvId:         show.GetId(),
+		SeasonNumber: 1,
+		Name:         "Сезон 1",
+		AirDate:      show.GetReleaseDate(),
+		EpisodeCount: episodesPerSeason,
+	}
+	if !withEpisodes {
+		return s
+	}
+
+	for n := int32(1); n <= episodesPerSeason; n++ {
+		s.Episodes = append(s.Episodes, &pb.Episode{
+			Id:            s.GetId()*100 + int64(n),
+			TvId:          show.GetId(),
+			SeasonNumber:  1,
+			EpisodeNumber: n,
+			Name:          fmt.Sprintf("Эпизод %d", n),
+			AirDate:       show.GetReleaseDate(),
+			Runtime:       24,
+		})
+	}
+	return s
+}
+
+// Discover учитывает фильтры грубо: весь фиктивный каталог — японская
+// анимация, поэтому любой другой жанр, язык или страна дают пустой список.
+// Числа оценок в каталоге нет, и фильтр по нему не применяется.
+func (p *Provider) Discover(ctx context.Context, mediaType pb.MediaType, query provider.DiscoverQuery) (*provider.MoviePage, error) {
+	items, runtime := p.movies, int32(120)
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		items, runtime = p.tvShows, 24
+	}
+
+	matches := (query.OriginalLanguage == "" || query.OriginalLanguage == "ja") &&
+		(query.OriginCountry == "" || query.OriginCountry == "JP") &&
+		!slices.Contains(query.ExcludeGenres, provider.GenreAnimation) &&
+		(query.MinRuntime == 0 || runtime >= query.MinRuntime) &&
+		(query.MaxRuntime == 0 || runtime <= query.MaxRuntime)
+	for _, g := range query.Genres {
+		matches = matches && g == provider.GenreAnimation
+	}
+	if !matches {
+		items = nil
+	}
+	items = slices.DeleteFunc(slices.Clone(items), func(m *pb.Movie) bool {
+		return (query.ReleasedFrom != "" && m.GetReleaseDate() < query.ReleasedFrom) ||
+			(query.ReleasedTo != "" && m.GetReleaseDate() > query.ReleasedTo) ||
+			m.GetVoteAverage() < query.MinRating
+	})
+
+	sorted := items
+	slices.SortStableFunc(sorted, func(a, b *pb.Movie) int {
+		switch query.SortBy {
+		case pb.SortOrder_SORT_ORDER_RELEASE_DATE_DESC:
+			return strings.Compare(b.GetReleaseDate(), a.GetReleaseDate())
+		case pb.SortOrder_SORT_ORDER_RELEASE_DATE_ASC:
+			return strings.Compare(a.GetReleaseDate(), b.GetReleaseDate())
+		case pb.SortOrder_SORT_ORDER_TITLE_ASC:
+			return strings.Compare(a.GetTitle(), b.GetTitle())
+		default:
+			return cmp.Compare(b.GetVoteAverage(), a.GetVoteAverage())
+		}
+	})
+	return paginate(sorted, query.Page), nil
+}
+
+func filter(items []*pb.Movie, query string) []*pb.Movie {
+	query = strings.ToLower(query)
+
+	var found []*pb.Movie
+	for _, m := range items {
+		if strings.Contains(strings.ToLower(m.GetTitle()), query) ||
+			strings.Contains(strings.ToLower(m.GetOriginalTitle()), query) {
+			found = append(found, m)
+		}
+	}
+	return found
+}
+
+func (p *Provider) SeasonalChart(ctx context.Context, query provider.SeasonQuery) (*provider.ChartPage, error) {
+	from, to := provider.SeasonRange(query.Year, query.Season)
+	inSeason := func(m *pb.Movie) bool {
+		return m.GetReleaseDate() >= from.Format("2006-01-02") && m.GetReleaseDate() <= to.Format("2006-01-02")
+	}
+
+	var entries []*pb.ChartEntry
+	if query.WantsFormat(pb.TitleFormat_TITLE_FORMAT_MOVIE) {
+		for _, m := range p.movies {
+			if inSeason(m) {
+				entries = append(entries, &pb.ChartEntry{
+					Title:        m,
+					Format:       pb.TitleFormat_TITLE_FORMAT_MOVIE,
+					Episodes:     1,
+					AiringStatus: pb.AiringStatus_AIRING_STATUS_FINISHED,
+				})
+			}
+		}
+	}
+	if query.WantsFormat(pb.TitleFormat_TITLE_FORMAT_TV) {
+		for _, m := range p.tvShows {
+			if inSeason(m) {
+				entries = append(entries, &pb.ChartEntry{
+					Title:        m,
+					Format:       pb.TitleFormat_TITLE_FORMAT_TV,
+					Episodes:     episodesPerSeason,
+					AiringStatus: pb.AiringStatus_AIRING_STATUS_FINISHED,
+				})
+			}
+		}
+	}
+
+	compare := provider.CompareBy(query.SortBy)
+	slices.SortStableFunc(entries, func(a, b *pb.ChartEntry) int {
+		return compare(a.GetTitle(), b.GetTitle())
+	})
+
+	// Весь каталог помещается на одну страницу.
+	result := &provider.ChartPage{Page: max(query.Page, 1), TotalPages: 1, TotalResults: int32(len(entries))}
+	if result.Page == 1 {
+		result.Results = entries
+	}
+	return result, nil
+}
+
+// Relations возвращает тайтл без связей: в фиктивном каталоге нет франшиз.
+func (p *Provider) Relations(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.TitleRelations, error) {
+	m, err := p.title(mediaType, id)
+	if err != nil {
+		return nil, err
+	}
+	format := pb.TitleFormat_TITLE_FORMAT_MOVIE
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		format = pb.TitleFormat_TITLE_FORMAT_TV
+	}
+	return &provider.TitleRelations{Title: m, Format: format}, nil
+}
+
+// Credits возвращает режиссёров тайтла; актёров в фиктивном каталоге нет.
+func (p *Provider) Credits(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.Credits, error) {
+	if _, err := p.title(mediaType, id); err != nil {
+		return nil, err
+	}
+	credits := &provider.Credits{}
+	for _, person := range p.people {
+		for _, m := range person.GetKnownFor() {
+			if m.GetMediaType() == mediaType && m.GetId() == id {
+				credits.Crew = append(credits.Crew, &pb.CrewMember{
+					Person: &pb.PersonSummary{
+						Id:                 person.GetId(),
+						Name:               person.GetName(),
+						KnownForDepartment: person.GetKnownForDepartment(),
+						Popularity:         person.GetPopularity(),
+					},
+					Department: "Directing",
+					Job:        "Director",
+				})
+			}
+		}
+	}
+	return credits, nil
+}
+
+// Characters возвращает пустой список: персонажей в фиктивном каталоге нет.
+func (p *Provider) Characters(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.CharacterPage, error) {
+	if _, err := p.title(mediaType, id); err != nil {
+		return nil, err
+	}
+	return &provider.CharacterPage{Page: max(page, 1)}, nil
+}
+
+func (p *Provider) Person(ctx context.Context, id int64, language string) (*pb.PersonDetails, error) {
+	for _, person := range p.people {
+		if person.GetId() != id {
+			continue
+		}
+		details := &pb.PersonDetails{
+			Id:                 person.GetId(),
+			Name:               person.GetName(),
+			KnownForDepartment: person.GetKnownForDepartment(),
+			Popularity:         person.GetPopularity(),
+		}
+		for _, m := range person.GetKnownFor() {
+			details.Filmography = append(details.Filmography, &pb.FilmographyCredit{
+				Title:      m,
+				Department: "Directing",
+				Job:        "Director",
+			})
+		}
+		provider.SortFilmography(details.Filmography)
+		return details, nil
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("человек с ID %d не найден", id))
+}
+
+// Videos возвращает пустой список: видео в фиктивном каталоге нет.
+func (p *Provider) Videos(ctx context.Context, mediaType pb.MediaType, id int64, language string) ([]*pb.Video, error) {
+	if _, err := p.title(mediaType, id); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// Trending возвращает каталог по убыванию оценки: истории просмотров в
+// фиктивном каталоге нет.
+func (p *Provider) Trending(ctx context.Context, mediaType pb.MediaType, window pb.TimeWindow, page int32, language string) (*provider.MoviePage, error) {
+	var items []*pb.Movie
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		items = slices.Clone(p.movies)
+	case pb.MediaType_MEDIA_TYPE_TV:
+		items = slices.Clone(p.tvShows)
+	default:
+		items = slices.Concat(p.movies, p.tvShows)
+	}
+	slices.SortStableFunc(items, func(a, b *pb.Movie) int {
+		return cmp.Compare(b.GetVoteAverage(), a.GetVoteAverage())
+	})
+	return paginate(items, page), nil
+}
+
+// Recommendations и Similar возвращают остальные тайтлы того же типа.
+func (p *Provider) Recommendations(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	return p.others(mediaType, id, page)
+}
+
+func (p *Provider) Similar(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	return p.others(mediaType, id, page)
+}
+
+func (p *Provider) others(mediaType pb.MediaType, id int64, page int32) (*provider.MoviePage, error) {
+	if _, err := p.title(mediaType, id); err != nil {
+		return nil, err
+	}
+	items := p.movies
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		items = p.tvShows
+	}
+	others := slices.DeleteFunc(slices.Clone(items), func(m *pb.Movie) bool {
+		return m.GetId() == id
+	})
+	return paginate(others, page), nil
+}
+
+// title ищет фильм или сериал каталога по ID.
+func (p *Provider) title(mediaType pb.MediaType, id int64) (*pb.Movie, error) {
+	items := p.movies
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		items = p.tvShows
+	}
+	for _, m := range items {
+		if m.GetId() == id {
+			return m, nil
+		}
+	}
+	return nil, provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("тайтл с ID %d не найден", id))
+}
+
+// AiringSchedule возвращает пустое расписание: все сериалы фиктивного
+// каталога завершены.
+func (p *Provider) AiringSchedule(ctx context.Context, from, to time.Time, page int32, language string) (*provider.SchedulePage, error) {
+	return &provider.SchedulePage{Page: max(page, 1)}, nil
+}
+
+func paginate(items []*pb.Movie, page int32) *provider.MoviePage {
+	if page < 1 {
+		page = 1
+	}
+
+	totalPages := int32((len(items) + pageSize - 1) / pageSize)
+	start := int(page-1) * pageSize
+	if start >= len(items) {
+		return &provider.MoviePage{Page: page, TotalPages: totalPages, TotalResults: int32(len(items))}
+	}
+	end := min(start+pageSize, len(items))
+
+	return &provider.MoviePage{
+		Results:      items[start:end],
+		Page:         page,
+		TotalPages:   totalPages,
+		TotalResults: int32(len(items)),
+	}
+}
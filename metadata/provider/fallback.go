@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// Fallback — источник, который обращается к Primary, а при его
+// недоступности, таймауте, превышении лимита или неподдерживаемом запросе
+// повторяет запрос к Secondary. Ошибки «не найдено» и ошибки разбора
+// ответа возвращаются как есть: ID у разных источников не совпадают.
+type Fallback struct {
+	Primary   Provider
+	Secondary Provider
+}
+
+var _ Provider = (*Fallback)(nil)
+
+// NewFallback возвращает Fallback с основным источником primary и
+// запасным secondary.
+func NewFallback(primary, secondary Provider) *Fallback {
+	return &Fallback{Primary: primary, Secondary: secondary}
+}
+
+func (f *Fallback) Name() string {
+	return f.Primary.Name()
+}
+
+func (f *Fallback) PopularMovies(ctx context.Context, page int32, language string) (*MoviePage, error) {
+	return fallback(ctx, f, func(p Provider) (*MoviePage, error) {
+		return p.PopularMovies(ctx, page, language)
+	})
+}
+
+func (f *Fallback) SearchMovies(ctx context.Context, query string, page int32, language string) (*MoviePage, error) {
+	return fallback(ctx, f, func(p Provider) (*MoviePage, error) {
+		return p.SearchMovies(ctx, query, page, language)
+	})
+}
+
+func (f *Fallback) SearchTVShows(ctx context.Context, query string, page int32, language string) (*MoviePage, error) {
+	return fallback(ctx, f, func(p Provider) (*MoviePage, error) {
+		return p.SearchTVShows(ctx, query, page, language)
+	})
+}
+
+func (f *Fallback) SearchPeople(ctx context.Context, query string, page int32, language string) (*PersonPage, error) {
+	return fallback(ctx, f, func(p Provider) (*PersonPage, error) {
+		return p.SearchPeople(ctx, query, page, language)
+	})
+}
+
+// MovieByID, TVShowByID, Season, Episode, Relations, Credits, Characters,
+// Person, Videos, Recommendations и Similar не переключаются на запасной
+// источник: ID относятся к пространству имён Primary.
+
+func (f *Fallback) MovieByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	return f.Primary.MovieByID(ctx, id, language)
+}
+
+func (f *Fallback) TVShowByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	return f.Primary.TVShowByID(ctx, id, language)
+}
+
+func (f *Fallback) Season(ctx context.Context, tvID int64, seasonNumber int32, language string) (*pb.Season, error) {
+	return f.Primary.Season(ctx, tvID, seasonNumber, language)
+}
+
+func (f *Fallback) Episode(ctx context.Context, tvID int64, seasonNumber, episodeNumber int32, language string) (*pb.Episode, error) {
+	return f.Primary.Episode(ctx, tvID, seasonNumber, episodeNumber, language)
+}
+
+func (f *Fallback) Relations(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*TitleRelations, error) {
+	return f.Primary.Relations(ctx, mediaType, id, language)
+}
+
+func (f *Fallback) Credits(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*Credits, error) {
+	return f.Primary.Credits(ctx, mediaType, id, language)
+}
+
+func (f *Fallback) Characters(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*CharacterPage, error) {
+	return f.Primary.Characters(ctx, mediaType, id, page, language)
+}
+
+func (f *Fallback) Person(ctx context.Context, id int64, language string) (*pb.PersonDetails, error) {
+	return f.Primary.Person(ctx, id, language)
+}
+
+func (f *Fallback) Videos(ctx context.Context, mediaType pb.MediaType, id int64, language string) ([]*pb.Video, error) {
+	return f.Primary.Videos(ctx, mediaType, id, language)
+}
+
+func (f *Fallback) Recommendations(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*MoviePage, error) {
+	return f.Primary.Recommendations(ctx, mediaType, id, page, language)
+}
+
+func (f *Fallback) Similar(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*MoviePage, error) {
+	return f.Primary.Similar(ctx, mediaType, id, page, language)
+}
+
+func (f *Fallback) Discover(ctx context.Context, mediaType pb.MediaType, query DiscoverQuery) (*MoviePage, error) {
+	return fallback(ctx, f, func(p Provider) (*MoviePage, error) {
+		return p.Discover(ctx, mediaType, query)
+	})
+}
+
+func (f *Fallback) Trending(ctx context.Context, mediaType pb.MediaType, window pb.TimeWindow, page int32, language string) (*MoviePage, error) {
+	return fallback(ctx, f, func(p Provider) (*MoviePage, error) {
+		return p.Trending(ctx, mediaType, window, page, language)
+	})
+}
+
+func (f *Fallback) SeasonalChart(ctx context.Context, query SeasonQuery) (*ChartPage, error) {
+	return fallback(ctx, f, func(p Provider) (*ChartPage, error) {
+		return p.SeasonalChart(ctx, query)
+	})
+}
+
+func (f *Fallback) AiringSchedule(ctx context.Context, from, to time.Time, page int32, language string) (*SchedulePage, error) {
+	return fallback(ctx, f, func(p Provider) (*SchedulePage, error) {
+		return p.AiringSchedule(ctx, from, to, page, language)
+	})
+}
+
+// UpstreamStats суммирует счётчики обоих источников.
+func (f *Fallback) UpstreamStats() UpstreamStats {
+	var total UpstreamStats
+	for _, p := range []Provider{f.Primary, f.Secondary} {
+		if r, ok := p.(StatsReporter); ok {
+			s := r.UpstreamStats()
+			total.Calls += s.Calls
+			total.Requests += s.Requests
+			total.Coalesced += s.Coalesced
+		}
+	}
+	return total
+}
+
+// fallback вызывает call у основного источника, а при ошибке, которую
+// можно обойти, — у запасного. Ответ запасного источника отмечается в
+// Origin контекста: его ID не относятся к основному.
+func fallback[T any](ctx context.Context, f *Fallback, call func(Provider) (T, error)) (T, error) {
+	result, err := call(f.Primary)
+	if err == nil || !shouldFallback(err) {
+		return result, err
+	}
+
+	log.Printf("Источник %s не ответил (%v), пробую %s", f.Primary.Name(), err, f.Secondary.Name())
+	result, err = call(f.Secondary)
+	if err == nil {
+		report(ctx, f.Secondary.Name(), true)
+	}
+	return result, err
+}
+
+func shouldFallback(err error) bool {
+	var e *Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	switch e.Kind {
+	case KindUnavailable, KindTimeout, KindRateLimited, KindUnsupported:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,12 @@
+package provider
+
+import pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+
+// NewImage возвращает изображение вида kind по пути path или nil, если
+// пути нет. Адреса в нужных размерах заполняет сервер.
+func NewImage(kind pb.ImageKind, path string) *pb.Image {
+	if path == "" {
+		return nil
+	}
+	return &pb.Image{Kind: kind, Path: path}
+}
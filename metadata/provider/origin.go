@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"sync"
+)
+
+// Origin запоминает, какой источник на самом деле ответил на запрос:
+// Fallback может ответить запасным источником, а запрос по ID с
+// пространством имён уходит к источнику этого пространства. ID в ответе
+// относятся к ответившему источнику, а не к выбранному для запроса.
+type Origin struct {
+	mu       sync.Mutex
+	name     string
+	fallback bool
+	partial  bool
+}
+
+type originKey struct{}
+
+// WithOrigin возвращает контекст, в который источники и обработчики
+// записывают Origin ответа.
+func WithOrigin(ctx context.Context) (context.Context, *Origin) {
+	o := &Origin{}
+	return context.WithValue(ctx, originKey{}, o), o
+}
+
+// OriginFrom возвращает Origin из контекста или nil, если его нет.
+func OriginFrom(ctx context.Context) *Origin {
+	o, _ := ctx.Value(originKey{}).(*Origin)
+	return o
+}
+
+// ReportOrigin отмечает, что ответ дал источник name. Без WithOrigin в
+// контексте ничего не делает.
+func ReportOrigin(ctx context.Context, name string) {
+	report(ctx, name, false)
+}
+
+func report(ctx context.Context, name string, fallback bool) {
+	o := OriginFrom(ctx)
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.name = name
+	// Если хотя бы одна часть ответа пришла от запасного источника, весь
+	// ответ считается запасным.
+	o.fallback = o.fallback || fallback
+}
+
+// ReportPartial отмечает, что ответ собран не полностью: часть запросов
+// к источнику не удалась. Без WithOrigin в контексте ничего не делает.
+func ReportPartial(ctx context.Context) {
+	o := OriginFrom(ctx)
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.partial = true
+}
+
+// Name возвращает имя ответившего источника или "", если о нём не
+// сообщалось: тогда ответил источник, выбранный для запроса.
+func (o *Origin) Name() string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.name
+}
+
+// Fallback сообщает, что основной источник не ответил и ответ (или его
+// часть) дал запасной.
+func (o *Origin) Fallback() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.fallback
+}
+
+// Partial сообщает, что ответ собран не полностью (см. ReportPartial).
+func (o *Origin) Partial() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.partial
+}
@@ -0,0 +1,180 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// Provider — источник метаданных, на который опирается metadata.Server.
+// Реализации отвечают только за получение данных и их преобразование в
+// proto-сообщения; валидация запросов остаётся в gRPC-обработчиках.
+type Provider interface {
+	// Name возвращает короткое имя источника (например, "tmdb").
+	Name() string
+
+	PopularMovies(ctx context.Context, page int32, language string) (*MoviePage, error)
+	SearchMovies(ctx context.Context, query string, page int32, language string) (*MoviePage, error)
+	SearchTVShows(ctx context.Context, query string, page int32, language string) (*MoviePage, error)
+	SearchPeople(ctx context.Context, query string, page int32, language string) (*PersonPage, error)
+	MovieByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error)
+
+	TVShowByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error)
+	Season(ctx context.Context, tvID int64, seasonNumber int32, language string) (*pb.Season, error)
+	Episode(ctx context.Context, tvID int64, seasonNumber, episodeNumber int32, language string) (*pb.Episode, error)
+
+	// Discover подбирает фильмы (MEDIA_TYPE_MOVIE) или сериалы (MEDIA_TYPE_TV)
+	// по фильтрам query.
+	Discover(ctx context.Context, mediaType pb.MediaType, query DiscoverQuery) (*MoviePage, error)
+
+	// Trending возвращает тайтлы, набирающие популярность за период window.
+	// Для MEDIA_TYPE_UNSPECIFIED в выдаче и фильмы, и сериалы.
+	Trending(ctx context.Context, mediaType pb.MediaType, window pb.TimeWindow, page int32, language string) (*MoviePage, error)
+
+	// SeasonalChart возвращает аниме, премьера которого пришлась на
+	// сезон query.Season года query.Year.
+	SeasonalChart(ctx context.Context, query SeasonQuery) (*ChartPage, error)
+
+	// AiringSchedule возвращает эпизоды, выходящие в промежутке [from, to),
+	// в порядке выхода.
+	AiringSchedule(ctx context.Context, from, to time.Time, page int32, language string) (*SchedulePage, error)
+
+	// Relations возвращает тайтл и его прямые связи с другими тайтлами.
+	Relations(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*TitleRelations, error)
+
+	// Credits возвращает актёров и съёмочную группу тайтла.
+	Credits(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*Credits, error)
+	// Characters возвращает персонажей тайтла с актёрами озвучивания.
+	Characters(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*CharacterPage, error)
+	// Person возвращает карточку человека с фильмографией.
+	Person(ctx context.Context, id int64, language string) (*pb.PersonDetails, error)
+
+	// Recommendations возвращает тайтлы, которые зрители советуют
+	// посмотреть после данного, а Similar — похожие на него по жанрам и
+	// ключевым словам.
+	Recommendations(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*MoviePage, error)
+	Similar(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*MoviePage, error)
+
+	// Videos возвращает видео тайтла на языке language. Источники, которые
+	// не знают языка видео, возвращают все видео при любом language.
+	Videos(ctx context.Context, mediaType pb.MediaType, id int64, language string) ([]*pb.Video, error)
+}
+
+// DiscoverQuery — фильтры и сортировка для Provider.Discover.
+type DiscoverQuery struct {
+	Page     int32
+	Language string
+	SortBy   pb.SortOrder
+
+	// Genres — ID жанров TMDb, которые должны быть у всех результатов.
+	Genres []int32
+	// ExcludeGenres — ID жанров TMDb, которых не должно быть ни у одного
+	// результата.
+	ExcludeGenres []int32
+	// OriginalLanguage — код ISO 639-1 языка оригинала, например "ja".
+	OriginalLanguage string
+	// OriginCountry — код ISO 3166-1 страны производства, например "JP".
+	OriginCountry string
+	// ReleasedFrom и ReleasedTo ограничивают дату выхода (YYYY-MM-DD,
+	// включительно); пустая строка — без ограничения.
+	ReleasedFrom string
+	ReleasedTo   string
+	// MinRating — нижняя граница средней оценки (0–10), MinVotes — числа
+	// оценок.
+	MinRating float64
+	MinVotes  int32
+	// MinRuntime и MaxRuntime ограничивают длительность фильма или эпизода
+	// в минутах, включительно.
+	MinRuntime int32
+	MaxRuntime int32
+}
+
+// GenreAnimation — ID жанра «Анимация» в TMDb (у фильмов и сериалов совпадает).
+const GenreAnimation = 16
+
+// AnimeQuery возвращает фильтры для японской анимации.
+func AnimeQuery(page int32, language string, sortBy pb.SortOrder) DiscoverQuery {
+	return DiscoverQuery{
+		Page:             page,
+		Language:         language,
+		SortBy:           sortBy,
+		Genres:           []int32{GenreAnimation},
+		OriginalLanguage: "ja",
+		OriginCountry:    "JP",
+	}
+}
+
+// CompareBy возвращает функцию сравнения карточек в порядке sortBy.
+func CompareBy(sortBy pb.SortOrder) func(a, b *pb.Movie) int {
+	return func(a, b *pb.Movie) int {
+		switch sortBy {
+		case pb.SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC:
+			return cmp.Compare(b.GetVoteAverage(), a.GetVoteAverage())
+		case pb.SortOrder_SORT_ORDER_RELEASE_DATE_DESC:
+			return strings.Compare(b.GetReleaseDate(), a.GetReleaseDate())
+		case pb.SortOrder_SORT_ORDER_RELEASE_DATE_ASC:
+			return strings.Compare(a.GetReleaseDate(), b.GetReleaseDate())
+		case pb.SortOrder_SORT_ORDER_TITLE_ASC:
+			return strings.Compare(a.GetTitle(), b.GetTitle())
+		default:
+			return cmp.Compare(b.GetPopularity(), a.GetPopularity())
+		}
+	}
+}
+
+// SortFilmography упорядочивает фильмографию от новых тайтлов к старым;
+// анонсированные тайтлы без даты идут первыми.
+func SortFilmography(credits []*pb.FilmographyCredit) {
+	slices.SortStableFunc(credits, func(a, b *pb.FilmographyCredit) int {
+		return strings.Compare(cmp.Or(b.GetTitle().GetReleaseDate(), "9999"), cmp.Or(a.GetTitle().GetReleaseDate(), "9999"))
+	})
+}
+
+// MoviePage — одна страница списка фильмов или сериалов.
+type MoviePage struct {
+	Results      []*pb.Movie
+	Page         int32
+	TotalPages   int32
+	TotalResults int32
+}
+
+// SchedulePage — одна страница расписания выхода эпизодов.
+type SchedulePage struct {
+	Results      []*pb.AiringEpisode
+	Page         int32
+	TotalPages   int32
+	TotalResults int32
+}
+
+// TitleRelations — тайтл и его прямые связи.
+type TitleRelations struct {
+	Title     *pb.Movie
+	Format    pb.TitleFormat
+	Relations []*pb.Relation
+}
+
+// Credits — состав тайтла.
+type Credits struct {
+	Cast []*pb.CastMember
+	Crew []*pb.CrewMember
+}
+
+// CharacterPage — одна страница списка персонажей.
+type CharacterPage struct {
+	Results      []*pb.Character
+	Page         int32
+	TotalPages   int32
+	TotalResults int32
+}
+
+// PersonPage — одна страница списка людей.
+type PersonPage struct {
+	Results      []*pb.PersonSummary
+	Page         int32
+	TotalPages   int32
+	TotalResults int32
+}
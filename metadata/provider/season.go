@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// SeasonQuery — параметры Provider.SeasonalChart.
+type SeasonQuery struct {
+	Year     int32
+	Season   pb.AnimeSeason
+	Page     int32
+	Language string
+	SortBy   pb.SortOrder
+	// Formats — допустимые форматы; пусто — все.
+	Formats []pb.TitleFormat
+}
+
+// ChartPage — одна страница сезонного чарта.
+type ChartPage struct {
+	Results      []*pb.ChartEntry
+	Page         int32
+	TotalPages   int32
+	TotalResults int32
+}
+
+// SeasonRange возвращает первый и последний день сезона season года year.
+func SeasonRange(year int32, season pb.AnimeSeason) (from, to time.Time) {
+	firstMonth := time.Month(3*(int(season)-1) + 1)
+	from = time.Date(int(year), firstMonth, 1, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(0, 3, -1)
+}
+
+// WantsFormat сообщает, входит ли format в запрошенные форматы.
+func (q SeasonQuery) WantsFormat(format pb.TitleFormat) bool {
+	if len(q.Formats) == 0 {
+		return true
+	}
+	for _, f := range q.Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,116 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// dateLayout — формат дат в параметрах и ответах TMDb.
+const dateLayout = "2006-01-02"
+
+// SeasonalChart подбирает японскую анимацию, вышедшую в сезон. TMDb
+// различает только фильмы и сериалы, поэтому все сериалы получают формат
+// TV, а запрос других форматов не поддерживается. Новые сезоны давно
+// идущих сериалов в чарт не попадают: фильтр работает по дате выхода
+// первого эпизода.
+func (p *Provider) SeasonalChart(ctx context.Context, query provider.SeasonQuery) (*provider.ChartPage, error) {
+	for _, f := range query.Formats {
+		if f != pb.TitleFormat_TITLE_FORMAT_MOVIE && f != pb.TitleFormat_TITLE_FORMAT_TV {
+			return nil, provider.Unsupported(p.Name(), fmt.Sprintf("сезонный чарт формата %s", f))
+		}
+	}
+
+	from, to := provider.SeasonRange(query.Year, query.Season)
+	discover := provider.AnimeQuery(query.Page, query.Language, query.SortBy)
+	discover.ReleasedFrom = from.Format(dateLayout)
+	discover.ReleasedTo = to.Format(dateLayout)
+
+	var (
+		wg              sync.WaitGroup
+		movies, shows   []*pb.ChartEntry
+		movieErr, tvErr error
+		pages           = make([]*provider.MoviePage, 2)
+	)
+	if query.WantsFormat(pb.TitleFormat_TITLE_FORMAT_MOVIE) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pages[0], movieErr = p.Discover(ctx, pb.MediaType_MEDIA_TYPE_MOVIE, discover)
+			if movieErr == nil {
+				movies = p.movieEntries(pages[0].Results)
+			}
+		}()
+	}
+	if query.WantsFormat(pb.TitleFormat_TITLE_FORMAT_TV) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pages[1], tvErr = p.Discover(ctx, pb.MediaType_MEDIA_TYPE_TV, discover)
+			if tvErr == nil {
+				shows = showEntries(pages[1].Results)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if movieErr != nil {
+		return nil, movieErr
+	}
+	if tvErr != nil {
+		return nil, tvErr
+	}
+
+	result := &provider.ChartPage{Page: max(query.Page, 1)}
+	for _, page := range pages {
+		if page != nil {
+			result.TotalPages = max(result.TotalPages, page.TotalPages)
+			result.TotalResults += page.TotalResults
+		}
+	}
+	result.Results = append(movies, shows...)
+	compare := provider.CompareBy(query.SortBy)
+	slices.SortStableFunc(result.Results, func(a, b *pb.ChartEntry) int {
+		return compare(a.GetTitle(), b.GetTitle())
+	})
+
+	log.Printf("Сезонный чарт %d %s от TMDb: %d тайтлов", query.Year, query.Season, len(result.Results))
+	return result, nil
+}
+
+func (p *Provider) movieEntries(movies []*pb.Movie) []*pb.ChartEntry {
+	entries := make([]*pb.ChartEntry, 0, len(movies))
+	for _, m := range movies {
+		var status pb.AiringStatus
+		if released, err := time.Parse(dateLayout, m.GetReleaseDate()); err == nil {
+			status = pb.AiringStatus_AIRING_STATUS_FINISHED
+			if released.After(time.Now()) {
+				status = pb.AiringStatus_AIRING_STATUS_NOT_YET_AIRED
+			}
+		}
+		entries = append(entries, &pb.ChartEntry{
+			Title:        m,
+			Format:       pb.TitleFormat_TITLE_FORMAT_MOVIE,
+			Episodes:     1,
+			AiringStatus: status,
+		})
+	}
+	return entries
+}
+
+// showEntries оформляет сериалы как записи чарта. Числа эпизодов и
+// статуса в ответе /discover/tv нет, а запрашивать ради них детали
+// каждого сериала слишком дорого, поэтому они остаются незаполненными.
+func showEntries(shows []*pb.Movie) []*pb.ChartEntry {
+	entries := make([]*pb.ChartEntry, 0, len(shows))
+	for _, show := range shows {
+		entries = append(entries, &pb.ChartEntry{Title: show, Format: pb.TitleFormat_TITLE_FORMAT_TV})
+	}
+	return entries
+}
@@ -0,0 +1,257 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"unicode"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// charactersPerPage — размер страницы персонажей. TMDb отдаёт состав
+// целиком, поэтому страницы нарезаются локально.
+const charactersPerPage = 25
+
+// creditsResponse — ответ /movie/{id}/credits и /tv/{id}/aggregate_credits.
+// У фильмов роль и должность заданы полями character и job, у сериалов —
+// списками roles и jobs по всем сезонам.
+type creditsResponse struct {
+	Cast []castMember `json:"cast"`
+	Crew []crewMember `json:"crew"`
+}
+
+type castMember struct {
+	person
+	Character string `json:"character"`
+	Order     int32  `json:"order"`
+	Roles     []struct {
+		Character    string `json:"character"`
+		EpisodeCount int32  `json:"episode_count"`
+	} `json:"roles"`
+	TotalEpisodeCount int32 `json:"total_episode_count"`
+}
+
+type crewMember struct {
+	person
+	Department string `json:"department"`
+	Job        string `json:"job"`
+	Jobs       []struct {
+		Job          string `json:"job"`
+		EpisodeCount int32  `json:"episode_count"`
+	} `json:"jobs"`
+}
+
+func (c castMember) character() string {
+	if len(c.Roles) == 0 {
+		return c.Character
+	}
+	var roles []string
+	for _, r := range c.Roles {
+		if r.Character != "" {
+			roles = append(roles, r.Character)
+		}
+	}
+	return strings.Join(roles, " / ")
+}
+
+func (c crewMember) job() string {
+	if len(c.Jobs) == 0 {
+		return c.Job
+	}
+	jobs := make([]string, len(c.Jobs))
+	for i, j := range c.Jobs {
+		jobs[i] = j.Job
+	}
+	return strings.Join(jobs, " / ")
+}
+
+// creditsPath возвращает путь состава тайтла: для сериалов — суммарный
+// состав всех сезонов.
+func (p *Provider) creditsPath(mediaType pb.MediaType, id int64) (string, error) {
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		return fmt.Sprintf("/movie/%d/credits", id), nil
+	case pb.MediaType_MEDIA_TYPE_TV:
+		return fmt.Sprintf("/tv/%d/aggregate_credits", id), nil
+	default:
+		return "", p.noCredits(mediaType)
+	}
+}
+
+// noCredits — ошибка запроса состава тайтла типа mediaType, у которого
+// состава нет.
+func (p *Provider) noCredits(mediaType pb.MediaType) error {
+	return provider.NewError(p.Name(), provider.KindNotFound, fmt.Errorf("у тайтлов типа %s нет состава", mediaType))
+}
+
+func (p *Provider) Credits(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.Credits, error) {
+	path, err := p.creditsPath(mediaType, id)
+	if err != nil {
+		return nil, err
+	}
+	var tmdbResponse creditsResponse
+	if err := p.get(ctx, path, url.Values{"language": {language}}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получен состав тайтла %d от TMDb: %d актёров, %d в съёмочной группе",
+		id, len(tmdbResponse.Cast), len(tmdbResponse.Crew))
+
+	credits := &provider.Credits{}
+	for _, c := range tmdbResponse.Cast {
+		credits.Cast = append(credits.Cast, &pb.CastMember{
+			Person:       toPersonSummary(c.person),
+			Character:    c.character(),
+			Order:        c.Order,
+			EpisodeCount: c.TotalEpisodeCount,
+		})
+	}
+	for _, c := range tmdbResponse.Crew {
+		credits.Crew = append(credits.Crew, &pb.CrewMember{
+			Person:     toPersonSummary(c.person),
+			Department: c.Department,
+			Job:        c.job(),
+		})
+	}
+	return credits, nil
+}
+
+// Characters собирает персонажей из актёрского состава. TMDb не ведёт
+// карточки персонажей и не различает дубляжи, поэтому у каждого персонажа
+// один актёр, озвучивший его на языке оригинала. Состав запрашивается
+// вместе с деталями тайтла, из которых берётся язык оригинала.
+func (p *Provider) Characters(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.CharacterPage, error) {
+	var path, appended string
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		path, appended = fmt.Sprintf("/movie/%d", id), "credits"
+	case pb.MediaType_MEDIA_TYPE_TV:
+		path, appended = fmt.Sprintf("/tv/%d", id), "aggregate_credits"
+	default:
+		return nil, p.noCredits(mediaType)
+	}
+	var tmdbResponse struct {
+		OriginalLanguage string          `json:"original_language"`
+		Credits          creditsResponse `json:"credits"`
+		AggregateCredits creditsResponse `json:"aggregate_credits"`
+	}
+	if err := p.get(ctx, path, url.Values{
+		"language":           {language},
+		"append_to_response": {appended},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+	cast := tmdbResponse.Credits.Cast
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		cast = tmdbResponse.AggregateCredits.Cast
+	}
+
+	var characters []*pb.Character
+	for _, c := range cast {
+		name := c.character()
+		if name == "" {
+			continue
+		}
+		characters = append(characters, &pb.Character{
+			Name: name,
+			VoiceActors: []*pb.VoiceActor{{
+				Person:   toPersonSummary(c.person),
+				Language: tmdbResponse.OriginalLanguage,
+			}},
+		})
+	}
+
+	page = max(page, 1)
+	result := &provider.CharacterPage{
+		Page:         page,
+		TotalPages:   int32((len(characters) + charactersPerPage - 1) / charactersPerPage),
+		TotalResults: int32(len(characters)),
+	}
+	if start := int(page-1) * charactersPerPage; start < len(characters) {
+		result.Results = characters[start:min(start+charactersPerPage, len(characters))]
+	}
+	return result, nil
+}
+
+type personDetails struct {
+	person
+	AlsoKnownAs     []string `json:"also_known_as"`
+	Biography       string   `json:"biography"`
+	Birthday        string   `json:"birthday"`
+	Deathday        string   `json:"deathday"`
+	PlaceOfBirth    string   `json:"place_of_birth"`
+	CombinedCredits struct {
+		Cast []struct {
+			anyTitle
+			Character string `json:"character"`
+		} `json:"cast"`
+		Crew []struct {
+			anyTitle
+			Department string `json:"department"`
+			Job        string `json:"job"`
+		} `json:"crew"`
+	} `json:"combined_credits"`
+}
+
+func (p *Provider) Person(ctx context.Context, id int64, language string) (*pb.PersonDetails, error) {
+	var tmdbResponse personDetails
+	if err := p.get(ctx, fmt.Sprintf("/person/%d", id), url.Values{
+		"language":           {language},
+		"append_to_response": {"combined_credits"},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получен человек от TMDb: %s", tmdbResponse.Name)
+
+	person := &pb.PersonDetails{
+		Id:                 tmdbResponse.ID,
+		Name:               tmdbResponse.Name,
+		NativeName:         japaneseName(tmdbResponse.AlsoKnownAs),
+		Biography:          tmdbResponse.Biography,
+		Birthday:           tmdbResponse.Birthday,
+		Deathday:           tmdbResponse.Deathday,
+		PlaceOfBirth:       tmdbResponse.PlaceOfBirth,
+		Profile:            provider.NewImage(pb.ImageKind_IMAGE_KIND_PROFILE, tmdbResponse.ProfilePath),
+		KnownForDepartment: tmdbResponse.KnownForDepartment,
+		Popularity:         tmdbResponse.Popularity,
+	}
+	for _, c := range tmdbResponse.CombinedCredits.Cast {
+		if title := c.toMovie(); title != nil {
+			person.Filmography = append(person.Filmography, &pb.FilmographyCredit{
+				Title:      title,
+				Character:  c.Character,
+				Department: "Acting",
+			})
+		}
+	}
+	for _, c := range tmdbResponse.CombinedCredits.Crew {
+		if title := c.toMovie(); title != nil {
+			person.Filmography = append(person.Filmography, &pb.FilmographyCredit{
+				Title:      title,
+				Department: c.Department,
+				Job:        c.Job,
+			})
+		}
+	}
+	provider.SortFilmography(person.Filmography)
+	return person, nil
+}
+
+// japaneseName выбирает из альтернативных имён первое, записанное
+// японскими символами. TMDb не выделяет имя на родном языке, но для
+// японских актёров и режиссёров оно почти всегда есть в also_known_as.
+func japaneseName(names []string) string {
+	for _, name := range names {
+		for _, r := range name {
+			if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) {
+				return name
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,35 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+func TestCreditsMediaType(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("запрос к TMDb: %s", r.URL.Path)
+	}))
+	defer srv.Close()
+	p := New("key", srv.URL, httpclient.New(httpclient.Config{}))
+	ctx := context.Background()
+
+	for _, mediaType := range []pb.MediaType{pb.MediaType_MEDIA_TYPE_UNSPECIFIED, pb.MediaType_MEDIA_TYPE_PERSON} {
+		t.Run(mediaType.String(), func(t *testing.T) {
+			_, creditsErr := p.Credits(ctx, mediaType, 1, "ru-RU")
+			_, charactersErr := p.Characters(ctx, mediaType, 1, 1, "ru-RU")
+			for _, err := range []error{creditsErr, charactersErr} {
+				var providerErr *provider.Error
+				if !errors.As(err, &providerErr) || providerErr.Kind != provider.KindNotFound || providerErr.Provider != "tmdb" {
+					t.Errorf("ошибка = %#v, want KindNotFound от tmdb", err)
+				}
+			}
+		})
+	}
+}
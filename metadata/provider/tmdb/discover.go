@@ -0,0 +1,109 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// minVotesForRating отсекает записи с парой оценок при сортировке по рейтингу.
+const minVotesForRating = 50
+
+func (p *Provider) Discover(ctx context.Context, mediaType pb.MediaType, query provider.DiscoverQuery) (*provider.MoviePage, error) {
+	params := url.Values{
+		"language": {query.Language},
+		"page":     {fmt.Sprint(max(query.Page, 1))},
+		"sort_by":  {sortParam(mediaType, query.SortBy)},
+	}
+	switch {
+	case query.MinVotes > 0:
+		params.Set("vote_count.gte", fmt.Sprint(query.MinVotes))
+	case query.SortBy == pb.SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC:
+		params.Set("vote_count.gte", fmt.Sprint(minVotesForRating))
+	}
+	if query.MinRating > 0 {
+		params.Set("vote_average.gte", fmt.Sprint(query.MinRating))
+	}
+	if len(query.Genres) > 0 {
+		params.Set("with_genres", joinIDs(query.Genres, ","))
+	}
+	if len(query.ExcludeGenres) > 0 {
+		params.Set("without_genres", joinIDs(query.ExcludeGenres, ","))
+	}
+	if query.MinRuntime > 0 {
+		params.Set("with_runtime.gte", fmt.Sprint(query.MinRuntime))
+	}
+	if query.MaxRuntime > 0 {
+		params.Set("with_runtime.lte", fmt.Sprint(query.MaxRuntime))
+	}
+	if query.OriginalLanguage != "" {
+		params.Set("with_original_language", query.OriginalLanguage)
+	}
+	if query.OriginCountry != "" {
+		params.Set("with_origin_country", query.OriginCountry)
+	}
+	dateField := "primary_release_date"
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		dateField = "first_air_date"
+	}
+	if query.ReleasedFrom != "" {
+		params.Set(dateField+".gte", query.ReleasedFrom)
+	}
+	if query.ReleasedTo != "" {
+		params.Set(dateField+".lte", query.ReleasedTo)
+	}
+
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		var tmdbResponse popularResponse
+		if err := p.get(ctx, "/discover/movie", params, &tmdbResponse); err != nil {
+			return nil, err
+		}
+		log.Printf("Подобрано %d фильмов от TMDb", len(tmdbResponse.Results))
+		return moviePage(tmdbResponse), nil
+
+	case pb.MediaType_MEDIA_TYPE_TV:
+		var tmdbResponse tvShowSearchResponse
+		if err := p.get(ctx, "/discover/tv", params, &tmdbResponse); err != nil {
+			return nil, err
+		}
+		log.Printf("Подобрано %d сериалов от TMDb", len(tmdbResponse.Results))
+		return tvShowPage(tmdbResponse), nil
+
+	default:
+		return nil, fmt.Errorf("подбор для типа %s не поддерживается", mediaType)
+	}
+}
+
+func sortParam(mediaType pb.MediaType, sortBy pb.SortOrder) string {
+	dateField, titleField := "primary_release_date", "title"
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		dateField, titleField = "first_air_date", "name"
+	}
+
+	switch sortBy {
+	case pb.SortOrder_SORT_ORDER_VOTE_AVERAGE_DESC:
+		return "vote_average.desc"
+	case pb.SortOrder_SORT_ORDER_RELEASE_DATE_DESC:
+		return dateField + ".desc"
+	case pb.SortOrder_SORT_ORDER_RELEASE_DATE_ASC:
+		return dateField + ".asc"
+	case pb.SortOrder_SORT_ORDER_TITLE_ASC:
+		return titleField + ".asc"
+	default:
+		return "popularity.desc"
+	}
+}
+
+func joinIDs(ids []int32, sep string) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprint(id)
+	}
+	return strings.Join(parts, sep)
+}
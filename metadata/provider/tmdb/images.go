@@ -0,0 +1,45 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	"github.com/waste3d/Hikari-Anime/metadata/images"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type configurationResponse struct {
+	Images struct {
+		SecureBaseURL string   `json:"secure_base_url"`
+		PosterSizes   []string `json:"poster_sizes"`
+		BackdropSizes []string `json:"backdrop_sizes"`
+		ProfileSizes  []string `json:"profile_sizes"`
+		StillSizes    []string `json:"still_sizes"`
+	} `json:"images"`
+}
+
+// ImageConfig загружает базовый адрес и размеры изображений из
+// /configuration. Подходит как images.Source.
+func (p *Provider) ImageConfig(ctx context.Context) (images.Config, error) {
+	var tmdbResponse configurationResponse
+	if err := p.get(ctx, "/configuration", url.Values{}, &tmdbResponse); err != nil {
+		return images.Config{}, err
+	}
+
+	r := tmdbResponse.Images
+	if r.SecureBaseURL == "" {
+		return images.Config{}, provider.NewError(p.Name(), provider.KindMalformed,
+			errors.New("в ответе /configuration нет images.secure_base_url"))
+	}
+	return images.Config{
+		BaseURL: r.SecureBaseURL,
+		Sizes: map[pb.ImageKind][]string{
+			pb.ImageKind_IMAGE_KIND_POSTER:   r.PosterSizes,
+			pb.ImageKind_IMAGE_KIND_BACKDROP: r.BackdropSizes,
+			pb.ImageKind_IMAGE_KIND_PROFILE:  r.ProfileSizes,
+			pb.ImageKind_IMAGE_KIND_STILL:    r.StillSizes,
+		},
+	}, nil
+}
@@ -0,0 +1,100 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type personSearchResponse struct {
+	Page         int      `json:"page"`
+	Results      []person `json:"results"`
+	TotalPages   int      `json:"total_pages"`
+	TotalResults int      `json:"total_results"`
+}
+
+type person struct {
+	ID                 int64      `json:"id"`
+	Name               string     `json:"name"`
+	ProfilePath        string     `json:"profile_path"`
+	KnownForDepartment string     `json:"known_for_department"`
+	Popularity         float64    `json:"popularity"`
+	KnownFor           []anyTitle `json:"known_for"`
+}
+
+// anyTitle — элемент смешанного списка TMDb, где фильм и сериал
+// различаются полем media_type.
+type anyTitle struct {
+	MediaType string `json:"media_type"`
+	movie
+	Name         string `json:"name"`
+	OriginalName string `json:"original_name"`
+	FirstAirDate string `json:"first_air_date"`
+}
+
+func (p *Provider) SearchPeople(ctx context.Context, query string, page int32, language string) (*provider.PersonPage, error) {
+	var tmdbResponse personSearchResponse
+	if err := p.get(ctx, "/search/person", url.Values{
+		"language": {language},
+		"query":    {query},
+		"page":     {fmt.Sprint(page)},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Найдено %d человек от TMDb", len(tmdbResponse.Results))
+
+	var people []*pb.PersonSummary
+	for _, r := range tmdbResponse.Results {
+		people = append(people, toPersonSummary(r))
+	}
+
+	return &provider.PersonPage{
+		Results:      people,
+		Page:         int32(tmdbResponse.Page),
+		TotalPages:   int32(tmdbResponse.TotalPages),
+		TotalResults: int32(tmdbResponse.TotalResults),
+	}, nil
+}
+
+func toPersonSummary(r person) *pb.PersonSummary {
+	summary := &pb.PersonSummary{
+		Id:                 r.ID,
+		Name:               r.Name,
+		Profile:            provider.NewImage(pb.ImageKind_IMAGE_KIND_PROFILE, r.ProfilePath),
+		KnownForDepartment: r.KnownForDepartment,
+		Popularity:         r.Popularity,
+	}
+	for _, t := range r.KnownFor {
+		if m := t.toMovie(); m != nil {
+			summary.KnownFor = append(summary.KnownFor, m)
+		}
+	}
+	return summary
+}
+
+// toMovie возвращает краткую карточку или nil для неизвестного media_type.
+func (t anyTitle) toMovie() *pb.Movie {
+	switch t.MediaType {
+	case "movie":
+		return toMovie(t.movie)
+	case "tv":
+		return toTVShow(tvShow{
+			ID:           t.ID,
+			Name:         t.Name,
+			OriginalName: t.OriginalName,
+			Overview:     t.Overview,
+			PosterPath:   t.PosterPath,
+			BackdropPath: t.BackdropPath,
+			FirstAirDate: t.FirstAirDate,
+			VoteAverage:  t.VoteAverage,
+			Popularity:   t.Popularity,
+		})
+	default:
+		return nil
+	}
+}
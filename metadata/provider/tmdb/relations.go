@@ -0,0 +1,84 @@
+package tmdb
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type collection struct {
+	ID    int64   `json:"id"`
+	Name  string  `json:"name"`
+	Parts []movie `json:"parts"`
+}
+
+// Relations строит связи фильма по коллекции TMDb: соседние по дате выхода
+// части становятся приквелом и сиквелом, остальные — прочими связями. У
+// сериалов в TMDb связей нет.
+func (p *Provider) Relations(ctx context.Context, mediaType pb.MediaType, id int64, language string) (*provider.TitleRelations, error) {
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		var show tvShow
+		if err := p.get(ctx, fmt.Sprintf("/tv/%d", id), url.Values{"language": {language}}, &show); err != nil {
+			return nil, err
+		}
+		return &provider.TitleRelations{Title: toTVShow(show), Format: pb.TitleFormat_TITLE_FORMAT_TV}, nil
+	}
+
+	var details struct {
+		movie
+		BelongsToCollection *struct {
+			ID int64 `json:"id"`
+		} `json:"belongs_to_collection"`
+	}
+	if err := p.get(ctx, fmt.Sprintf("/movie/%d", id), url.Values{"language": {language}}, &details); err != nil {
+		return nil, err
+	}
+
+	result := &provider.TitleRelations{
+		Title:  toMovie(details.movie),
+		Format: pb.TitleFormat_TITLE_FORMAT_MOVIE,
+	}
+	if details.BelongsToCollection == nil {
+		return result, nil
+	}
+
+	var c collection
+	if err := p.get(ctx, fmt.Sprintf("/collection/%d", details.BelongsToCollection.ID), url.Values{"language": {language}}, &c); err != nil {
+		return nil, err
+	}
+
+	// Части без даты (анонсы) идут в конце.
+	parts := slices.Clone(c.Parts)
+	slices.SortStableFunc(parts, func(a, b movie) int {
+		return cmp.Compare(cmp.Or(a.ReleaseDate, "9999"), cmp.Or(b.ReleaseDate, "9999"))
+	})
+	self := slices.IndexFunc(parts, func(m movie) bool { return m.ID == id })
+	for i, part := range parts {
+		if i == self {
+			continue
+		}
+		relation := pb.RelationType_RELATION_TYPE_OTHER
+		switch {
+		case self < 0:
+			// Фильма нет среди частей коллекции: соседи неизвестны.
+		case i == self-1:
+			relation = pb.RelationType_RELATION_TYPE_PREQUEL
+		case i == self+1:
+			relation = pb.RelationType_RELATION_TYPE_SEQUEL
+		}
+		result.Relations = append(result.Relations, &pb.Relation{
+			Type:   relation,
+			Title:  toMovie(part),
+			Format: pb.TitleFormat_TITLE_FORMAT_MOVIE,
+		})
+	}
+
+	log.Printf("Получена коллекция TMDb %q: %d частей", c.Name, len(c.Parts))
+	return result, nil
+}
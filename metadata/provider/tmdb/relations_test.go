@@ -0,0 +1,95 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+func TestRelations(t *testing.T) {
+	// Коллекция «Евангелион: Новый фильм»; часть без даты — анонс.
+	parts := []map[string]any{
+		{"id": 22843, "title": "Evangelion: 2.0", "release_date": "2009-06-27"},
+		{"id": 15137, "title": "Evangelion: 1.0", "release_date": "2007-09-01"},
+		{"id": 999, "title": "Анонс"},
+		{"id": 75629, "title": "Evangelion: 3.0", "release_date": "2012-11-17"},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/movie/22843":
+			json.NewEncoder(w).Encode(map[string]any{"id": 22843, "belongs_to_collection": map[string]any{"id": 210303}})
+		case "/movie/1":
+			// Фильм ссылается на коллекцию, в списке частей которой его нет.
+			json.NewEncoder(w).Encode(map[string]any{"id": 1, "belongs_to_collection": map[string]any{"id": 210303}})
+		case "/movie/2":
+			json.NewEncoder(w).Encode(map[string]any{"id": 2})
+		case "/collection/210303":
+			json.NewEncoder(w).Encode(map[string]any{"id": 210303, "parts": parts})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	p := New("key", srv.URL, httpclient.New(httpclient.Config{}))
+
+	const (
+		prequel = pb.RelationType_RELATION_TYPE_PREQUEL
+		sequel  = pb.RelationType_RELATION_TYPE_SEQUEL
+		other   = pb.RelationType_RELATION_TYPE_OTHER
+	)
+	tests := []struct {
+		name string
+		id   int64
+		want map[int64]pb.RelationType
+	}{
+		{
+			name: "соседи по дате — приквел и сиквел",
+			id:   22843,
+			want: map[int64]pb.RelationType{15137: prequel, 75629: sequel, 999: other},
+		},
+		{
+			name: "фильма нет в коллекции",
+			id:   1,
+			want: map[int64]pb.RelationType{15137: other, 22843: other, 75629: other, 999: other},
+		},
+		{
+			name: "без коллекции",
+			id:   2,
+			want: map[int64]pb.RelationType{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.Relations(context.Background(), pb.MediaType_MEDIA_TYPE_MOVIE, tt.id, "ru-RU")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Title.GetId() != tt.id || got.Format != pb.TitleFormat_TITLE_FORMAT_MOVIE {
+				t.Errorf("тайтл = %v, %v", got.Title, got.Format)
+			}
+			relations := make(map[int64]pb.RelationType)
+			var ids []int64
+			for _, r := range got.Relations {
+				relations[r.GetTitle().GetId()] = r.GetType()
+				ids = append(ids, r.GetTitle().GetId())
+			}
+			if len(relations) != len(tt.want) {
+				t.Errorf("связи = %v, want %v", relations, tt.want)
+			}
+			for id, want := range tt.want {
+				if relations[id] != want {
+					t.Errorf("связь с %d = %v, want %v", id, relations[id], want)
+				}
+			}
+			if i := slices.Index(ids, 999); i >= 0 && i != len(ids)-1 {
+				t.Errorf("часть без даты не в конце: %v", ids)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package tmdb
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+const (
+	// schedulePerPage — эпизодов на странице расписания, как у остальных
+	// списков TMDb.
+	schedulePerPage = 20
+	// scheduleMaxPages ограничивает число страниц /discover/tv, из которых
+	// собирается расписание: по 20 самых популярных сериалов на каждой.
+	scheduleMaxPages = 5
+	// scheduleWorkers — сколько деталей сериалов запрашивается одновременно.
+	scheduleWorkers = 4
+)
+
+// AiringSchedule подбирает аниме-сериалы, у которых в промежутке выходят
+// эпизоды, и берёт из их деталей последний вышедший и следующий эпизоды.
+// TMDb знает только даты выхода, поэтому все эпизоды помечаются date_only,
+// а в расписание попадают не больше двух эпизодов каждого сериала. Даты
+// промежутка берутся в часовом поясе запроса, в котором заданы from и to.
+//
+// Страницы считаются по эпизодам: расписание собирается из не больше
+// scheduleMaxPages страниц сериалов, сортируется по дате выхода и уже
+// потом делится на страницы по schedulePerPage эпизодов.
+func (p *Provider) AiringSchedule(ctx context.Context, from, to time.Time, page int32, language string) (*provider.SchedulePage, error) {
+	fromDate := from.Format(dateLayout)
+	lastDate := to.Add(-time.Nanosecond).Format(dateLayout)
+
+	shows, err := p.airingShows(ctx, fromDate, lastDate, language)
+	if err != nil {
+		return nil, err
+	}
+	episodes := p.airingEpisodes(ctx, shows, fromDate, lastDate, language)
+	slices.SortFunc(episodes, func(a, b *pb.AiringEpisode) int {
+		return cmp.Or(
+			cmp.Compare(a.GetAirsAtUnix(), b.GetAirsAtUnix()),
+			cmp.Compare(b.GetTitle().GetPopularity(), a.GetTitle().GetPopularity()),
+		)
+	})
+
+	log.Printf("Получено %d эпизодов расписания от TMDb", len(episodes))
+
+	page = max(page, 1)
+	start := min(int(page-1)*schedulePerPage, len(episodes))
+	end := min(start+schedulePerPage, len(episodes))
+	return &provider.SchedulePage{
+		Results:      episodes[start:end],
+		Page:         page,
+		TotalPages:   int32((len(episodes) + schedulePerPage - 1) / schedulePerPage),
+		TotalResults: int32(len(episodes)),
+	}, nil
+}
+
+// airingShows возвращает сериалы, у которых в промежутке выходят эпизоды,
+// по убыванию популярности. Ошибка первой страницы возвращается, а
+// следующих — только отмечает ответ неполным.
+func (p *Provider) airingShows(ctx context.Context, fromDate, lastDate, language string) ([]tvShow, error) {
+	var shows []tvShow
+	for page := 1; page <= scheduleMaxPages; page++ {
+		var tmdbResponse tvShowSearchResponse
+		if err := p.get(ctx, "/discover/tv", url.Values{
+			"language":               {language},
+			"page":                   {fmt.Sprint(page)},
+			"sort_by":                {"popularity.desc"},
+			"with_genres":            {fmt.Sprint(provider.GenreAnimation)},
+			"with_original_language": {"ja"},
+			"with_origin_country":    {"JP"},
+			"air_date.gte":           {fromDate},
+			"air_date.lte":           {lastDate},
+		}, &tmdbResponse); err != nil {
+			if page == 1 {
+				return nil, err
+			}
+			log.Printf("не удалось получить страницу %d сериалов для расписания: %v", page, err)
+			provider.ReportPartial(ctx)
+			break
+		}
+		shows = append(shows, tmdbResponse.Results...)
+		if page >= tmdbResponse.TotalPages {
+			break
+		}
+	}
+	return shows, nil
+}
+
+// airingEpisodes запрашивает детали shows, не больше scheduleWorkers
+// одновременно, и возвращает их эпизоды, выходящие в промежутке.
+func (p *Provider) airingEpisodes(ctx context.Context, shows []tvShow, fromDate, lastDate, language string) []*pb.AiringEpisode {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		episodes []*pb.AiringEpisode
+		sem      = make(chan struct{}, scheduleWorkers)
+	)
+	for _, show := range shows {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			var details tvShowDetails
+			if err := p.get(ctx, fmt.Sprintf("/tv/%d", show.ID), url.Values{"language": {language}}, &details); err != nil {
+				log.Printf("не удалось получить детали сериала %d для расписания: %v", show.ID, err)
+				provider.ReportPartial(ctx)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, e := range []*episode{details.LastEpisodeToAir, details.NextEpisodeToAir} {
+				if e != nil && e.AirDate >= fromDate && e.AirDate <= lastDate {
+					episodes = append(episodes, toAiringEpisode(show, *e))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return episodes
+}
+
+func toAiringEpisode(show tvShow, e episode) *pb.AiringEpisode {
+	result := &pb.AiringEpisode{
+		Title:         toTVShow(show),
+		SeasonNumber:  e.SeasonNumber,
+		EpisodeNumber: e.EpisodeNumber,
+		Name:          e.Name,
+		DateOnly:      true,
+		Runtime:       e.Runtime,
+	}
+	if airDate, err := time.Parse(dateLayout, e.AirDate); err == nil {
+		result.AirsAtUnix = airDate.Unix()
+	}
+	return result
+}
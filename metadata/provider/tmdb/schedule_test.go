@@ -0,0 +1,139 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// scheduleStub — заглушка TMDb с showPages страницами по 20 сериалов, у
+// каждого из которых в расписании выходит один эпизод. Сериал failing
+// отвечает ошибкой.
+type scheduleStub struct {
+	showPages int
+	failing   int64
+
+	discovers      atomic.Int32
+	inFlight, peak atomic.Int32
+}
+
+func (s *scheduleStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/discover/tv" {
+		s.discovers.Add(1)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		var results []map[string]any
+		for i := range 20 {
+			id := (page-1)*20 + i + 1
+			results = append(results, map[string]any{"id": id, "name": fmt.Sprintf("Сериал %d", id), "popularity": 1000 - id})
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"page": page, "results": results, "total_pages": s.showPages, "total_results": 20 * s.showPages,
+		})
+		return
+	}
+
+	id, err := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/tv/"), 10, 64)
+	if err != nil || id == s.failing {
+		http.Error(w, "нет", http.StatusNotFound)
+		return
+	}
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+	for p := s.peak.Load(); n > p && !s.peak.CompareAndSwap(p, n); p = s.peak.Load() {
+	}
+	time.Sleep(time.Millisecond)
+
+	// Эпизоды выходят по одному в день, начиная с 1 апреля.
+	json.NewEncoder(w).Encode(map[string]any{
+		"id": id,
+		"next_episode_to_air": map[string]any{
+			"air_date":       time.Date(2024, 4, 1+int(id)%7, 0, 0, 0, 0, time.UTC).Format(dateLayout),
+			"episode_number": 100 + id,
+			"season_number":  1,
+		},
+		"last_episode_to_air": map[string]any{"air_date": "2024-03-01", "episode_number": 1, "season_number": 1},
+	})
+}
+
+func TestAiringSchedule(t *testing.T) {
+	from := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name          string
+		stub          *scheduleStub
+		page          int32
+		wantResults   int
+		wantTotal     int32
+		wantPages     int32
+		wantDiscovers int32
+		wantPartial   bool
+	}{
+		{
+			name: "первая страница эпизодов", stub: &scheduleStub{showPages: 2}, page: 1,
+			wantResults: schedulePerPage, wantTotal: 40, wantPages: 2, wantDiscovers: 2,
+		},
+		{
+			name: "последняя страница эпизодов", stub: &scheduleStub{showPages: 2}, page: 2,
+			wantResults: schedulePerPage, wantTotal: 40, wantPages: 2, wantDiscovers: 2,
+		},
+		{
+			name: "страница за концом", stub: &scheduleStub{showPages: 1}, page: 3,
+			wantTotal: 20, wantPages: 1, wantDiscovers: 1,
+		},
+		{
+			name: "не больше scheduleMaxPages страниц сериалов", stub: &scheduleStub{showPages: 50}, page: 1,
+			wantResults: schedulePerPage, wantTotal: 20 * scheduleMaxPages, wantPages: scheduleMaxPages, wantDiscovers: scheduleMaxPages,
+		},
+		{
+			name: "сериал с ошибкой пропускается", stub: &scheduleStub{showPages: 1, failing: 7}, page: 1,
+			wantResults: 19, wantTotal: 19, wantPages: 1, wantDiscovers: 1, wantPartial: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.stub)
+			defer srv.Close()
+			p := New("key", srv.URL, httpclient.New(httpclient.Config{}))
+			ctx, origin := provider.WithOrigin(context.Background())
+
+			got, err := p.AiringSchedule(ctx, from, to, tt.page, "ru-RU")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Results) != tt.wantResults || got.TotalResults != tt.wantTotal || got.TotalPages != tt.wantPages || got.Page != tt.page {
+				t.Errorf("%d эпизодов, всего %d на %d страницах, страница %d; want %d, %d, %d, %d",
+					len(got.Results), got.TotalResults, got.TotalPages, got.Page, tt.wantResults, tt.wantTotal, tt.wantPages, tt.page)
+			}
+			if n := tt.stub.discovers.Load(); n != tt.wantDiscovers {
+				t.Errorf("запросов /discover/tv = %d, want %d", n, tt.wantDiscovers)
+			}
+			if peak := tt.stub.peak.Load(); peak > scheduleWorkers {
+				t.Errorf("одновременных запросов деталей = %d, want не больше %d", peak, scheduleWorkers)
+			}
+			if origin.Partial() != tt.wantPartial {
+				t.Errorf("Partial = %v, want %v", origin.Partial(), tt.wantPartial)
+			}
+			for i := 1; i < len(got.Results); i++ {
+				if got.Results[i].GetAirsAtUnix() < got.Results[i-1].GetAirsAtUnix() {
+					t.Fatalf("эпизоды не отсортированы по дате: %d после %d", i, i-1)
+				}
+			}
+			for _, e := range got.Results {
+				if e.GetEpisodeNumber() == 1 {
+					t.Errorf("в расписание попал эпизод вне промежутка: %v", e)
+				}
+			}
+		})
+	}
+}
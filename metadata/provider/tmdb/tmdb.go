@@ -0,0 +1,311 @@
+package tmdb
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/waste3d/Hikari-Anime/metadata/httpclient"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type popularResponse struct {
+	Page         int     `json:"page"`
+	Results      []movie `json:"results"`
+	TotalPages   int     `json:"total_pages"`
+	TotalResults int     `json:"total_results"`
+}
+
+type movie struct {
+	ID            int64   `json:"id"`
+	Title         string  `json:"title"`
+	OriginalTitle string  `json:"original_title"`
+	Overview      string  `json:"overview"`
+	PosterPath    string  `json:"poster_path"`
+	BackdropPath  string  `json:"backdrop_path"`
+	ReleaseDate   string  `json:"release_date"`
+	VoteAverage   float64 `json:"vote_average"`
+	Popularity    float64 `json:"popularity"`
+}
+
+type genre struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+type movieDetails struct {
+	movie
+	Tagline             string   `json:"tagline"`
+	Status              string   `json:"status"`
+	Genres              []genre  `json:"genres"`
+	Runtime             int32    `json:"runtime"`
+	OriginalLanguage    string   `json:"original_language"`
+	OriginCountry       []string `json:"origin_country"`
+	ProductionCountries []struct {
+		ISO3166 string `json:"iso_3166_1"`
+	} `json:"production_countries"`
+	Adult             bool        `json:"adult"`
+	VoteCount         int32       `json:"vote_count"`
+	ExternalIDs       externalIDs `json:"external_ids"`
+	AlternativeTitles struct {
+		Titles []alternativeTitle `json:"titles"`
+	} `json:"alternative_titles"`
+}
+
+// alternativeTitle — элемент ответа append_to_response=alternative_titles.
+type alternativeTitle struct {
+	Title string `json:"title"`
+}
+
+// alternativeTitles возвращает уникальные названия из titles, кроме
+// совпадающих с skip.
+func alternativeTitles(titles []alternativeTitle, skip ...string) []string {
+	var result []string
+	for _, t := range titles {
+		if t.Title != "" && !slices.Contains(skip, t.Title) && !slices.Contains(result, t.Title) {
+			result = append(result, t.Title)
+		}
+	}
+	return result
+}
+
+// externalIDs — ответ append_to_response=external_ids.
+type externalIDs struct {
+	IMDbID string `json:"imdb_id"`
+	TVDBID int64  `json:"tvdb_id"`
+}
+
+// toMap возвращает ID тайтла id во всех известных TMDb источниках.
+func (e externalIDs) toMap(id int64) map[string]string {
+	ids := map[string]string{"tmdb": strconv.FormatInt(id, 10)}
+	if e.IMDbID != "" {
+		ids["imdb"] = e.IMDbID
+	}
+	if e.TVDBID != 0 {
+		ids["tvdb"] = strconv.FormatInt(e.TVDBID, 10)
+	}
+	return ids
+}
+
+type tvShowSearchResponse struct {
+	Page         int      `json:"page"`
+	Results      []tvShow `json:"results"`
+	TotalPages   int      `json:"total_pages"`
+	TotalResults int      `json:"total_results"`
+}
+
+type tvShow struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	OriginalName string  `json:"original_name"`
+	Overview     string  `json:"overview"`
+	PosterPath   string  `json:"poster_path"`
+	BackdropPath string  `json:"backdrop_path"`
+	FirstAirDate string  `json:"first_air_date"`
+	VoteAverage  float64 `json:"vote_average"`
+	Popularity   float64 `json:"popularity"`
+}
+
+// Provider получает метаданные из TMDb API v3. Одновременные одинаковые
+// запросы к API объединяются в один.
+type Provider struct {
+	apiKey  string
+	baseURL string
+	client  *httpclient.Client
+
+	inflight provider.Coalescer
+}
+
+var (
+	_ provider.Provider      = (*Provider)(nil)
+	_ provider.StatsReporter = (*Provider)(nil)
+)
+
+func New(apiKey, baseURL string, client *httpclient.Client) *Provider {
+	return &Provider{apiKey: apiKey, baseURL: baseURL, client: client}
+}
+
+func (p *Provider) Name() string {
+	return "tmdb"
+}
+
+func (p *Provider) UpstreamStats() provider.UpstreamStats {
+	return p.inflight.Stats()
+}
+
+func (p *Provider) PopularMovies(ctx context.Context, page int32, language string) (*provider.MoviePage, error) {
+	var tmdbResponse popularResponse
+	if err := p.get(ctx, "/movie/popular", url.Values{
+		"language": {language},
+		"page":     {fmt.Sprint(page)},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получено %d фильмов от TMDb", len(tmdbResponse.Results))
+	return moviePage(tmdbResponse), nil
+}
+
+func (p *Provider) SearchMovies(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	var tmdbResponse popularResponse
+	if err := p.get(ctx, "/search/movie", url.Values{
+		"language": {language},
+		"query":    {query},
+		"page":     {fmt.Sprint(page)},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Найдено %d фильмов от TMDb", len(tmdbResponse.Results))
+	return moviePage(tmdbResponse), nil
+}
+
+func (p *Provider) SearchTVShows(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	var tmdbResponse tvShowSearchResponse
+	if err := p.get(ctx, "/search/tv", url.Values{
+		"language": {language},
+		"query":    {query},
+		"page":     {fmt.Sprint(page)},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Найдено %d сериалов от TMDb", len(tmdbResponse.Results))
+	return tvShowPage(tmdbResponse), nil
+}
+
+func (p *Provider) MovieByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	var tmdbResponse movieDetails
+	if err := p.get(ctx, fmt.Sprintf("/movie/%d", id), url.Values{
+		"language":           {language},
+		"append_to_response": {"external_ids,alternative_titles"},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получен фильм от TMDb: %s", tmdbResponse.Title)
+
+	originCountry := tmdbResponse.OriginCountry
+	if len(originCountry) == 0 {
+		for _, c := range tmdbResponse.ProductionCountries {
+			originCountry = append(originCountry, c.ISO3166)
+		}
+	}
+
+	return &pb.TitleDetails{
+		Id:               tmdbResponse.ID,
+		MediaType:        pb.MediaType_MEDIA_TYPE_MOVIE,
+		Title:            tmdbResponse.Title,
+		OriginalTitle:    tmdbResponse.OriginalTitle,
+		Overview:         tmdbResponse.Overview,
+		Tagline:          tmdbResponse.Tagline,
+		Poster:           provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, tmdbResponse.PosterPath),
+		Backdrop:         provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, tmdbResponse.BackdropPath),
+		ReleaseDate:      tmdbResponse.ReleaseDate,
+		Status:           tmdbResponse.Status,
+		Genres:           toGenres(tmdbResponse.Genres),
+		Runtime:          tmdbResponse.Runtime,
+		OriginalLanguage: tmdbResponse.OriginalLanguage,
+		OriginCountry:    originCountry,
+		Adult:            tmdbResponse.Adult,
+		VoteAverage:      tmdbResponse.VoteAverage,
+		VoteCount:        tmdbResponse.VoteCount,
+		Popularity:       tmdbResponse.Popularity,
+		ExternalIds:      tmdbResponse.ExternalIDs.toMap(tmdbResponse.ID),
+		AlternativeTitles: alternativeTitles(tmdbResponse.AlternativeTitles.Titles,
+			tmdbResponse.Title, tmdbResponse.OriginalTitle),
+	}, nil
+}
+
+// get выполняет GET-запрос к TMDb и декодирует JSON-ответ в out.
+func (p *Provider) get(ctx context.Context, path string, params url.Values, out any) error {
+	params.Set("api_key", p.apiKey)
+	requestURL := p.baseURL + path + "?" + params.Encode()
+
+	body, err := p.inflight.Do(ctx, requestURL, func(ctx context.Context) ([]byte, error) {
+		log.Printf("Выполняю запрос к TMDb: %s", path)
+		return p.client.Get(ctx, requestURL)
+	})
+	if err != nil {
+		return provider.Classify(p.Name(), err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		log.Printf("ОШИБКА при декодировании JSON: %v", err)
+		return provider.NewError(p.Name(), provider.KindMalformed,
+			fmt.Errorf("ошибка при декодировании ответа от TMDb: %w", err))
+	}
+
+	return nil
+}
+
+func moviePage(r popularResponse) *provider.MoviePage {
+	var movies []*pb.Movie
+	for _, m := range r.Results {
+		movies = append(movies, toMovie(m))
+	}
+
+	return &provider.MoviePage{
+		Results:      movies,
+		Page:         int32(r.Page),
+		TotalPages:   int32(r.TotalPages),
+		TotalResults: int32(r.TotalResults),
+	}
+}
+
+func tvShowPage(r tvShowSearchResponse) *provider.MoviePage {
+	var tvShows []*pb.Movie
+	for _, tvShow := range r.Results {
+		tvShows = append(tvShows, toTVShow(tvShow))
+	}
+
+	return &provider.MoviePage{
+		Results:      tvShows,
+		Page:         int32(r.Page),
+		TotalPages:   int32(r.TotalPages),
+		TotalResults: int32(r.TotalResults),
+	}
+}
+
+func toMovie(m movie) *pb.Movie {
+	return &pb.Movie{
+		Id:            m.ID,
+		Title:         m.Title,
+		OriginalTitle: m.OriginalTitle,
+		Poster:        provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, m.PosterPath),
+		Backdrop:      provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, m.BackdropPath),
+		Overview:      m.Overview,
+		ReleaseDate:   m.ReleaseDate,
+		VoteAverage:   m.VoteAverage,
+		MediaType:     pb.MediaType_MEDIA_TYPE_MOVIE,
+		Popularity:    m.Popularity,
+	}
+}
+
+func toTVShow(tvShow tvShow) *pb.Movie {
+	return &pb.Movie{
+		Id:            tvShow.ID,
+		Title:         tvShow.Name,
+		OriginalTitle: tvShow.OriginalName,
+		Poster:        provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, tvShow.PosterPath),
+		Backdrop:      provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, tvShow.BackdropPath),
+		Overview:      tvShow.Overview,
+		ReleaseDate:   tvShow.FirstAirDate,
+		VoteAverage:   tvShow.VoteAverage,
+		MediaType:     pb.MediaType_MEDIA_TYPE_TV,
+		Popularity:    tvShow.Popularity,
+	}
+}
+
+func toGenres(genres []genre) []*pb.Genre {
+	var result []*pb.Genre
+	for _, g := range genres {
+		result = append(result, &pb.Genre{Id: g.ID, Name: g.Name})
+	}
+	return result
+}
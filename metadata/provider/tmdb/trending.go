@@ -0,0 +1,101 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type trendingResponse struct {
+	Page         int        `json:"page"`
+	Results      []anyTitle `json:"results"`
+	TotalPages   int        `json:"total_pages"`
+	TotalResults int        `json:"total_results"`
+}
+
+// Trending пропускает людей, которые попадают в тренды "all" вместе с
+// тайтлами, поэтому на странице может быть меньше 20 записей.
+func (p *Provider) Trending(ctx context.Context, mediaType pb.MediaType, window pb.TimeWindow, page int32, language string) (*provider.MoviePage, error) {
+	var kind string
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		kind = "movie"
+	case pb.MediaType_MEDIA_TYPE_TV:
+		kind = "tv"
+	case pb.MediaType_MEDIA_TYPE_UNSPECIFIED:
+		kind = "all"
+	default:
+		return nil, fmt.Errorf("тренды для типа %s не поддерживаются", mediaType)
+	}
+	period := "week"
+	if window == pb.TimeWindow_TIME_WINDOW_DAY {
+		period = "day"
+	}
+
+	var tmdbResponse trendingResponse
+	if err := p.get(ctx, fmt.Sprintf("/trending/%s/%s", kind, period), url.Values{
+		"language": {language},
+		"page":     {fmt.Sprint(max(page, 1))},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получено %d трендов от TMDb", len(tmdbResponse.Results))
+
+	result := &provider.MoviePage{
+		Page:         int32(tmdbResponse.Page),
+		TotalPages:   int32(tmdbResponse.TotalPages),
+		TotalResults: int32(tmdbResponse.TotalResults),
+	}
+	for _, t := range tmdbResponse.Results {
+		if t.MediaType == "" && kind != "all" {
+			t.MediaType = kind
+		}
+		if m := t.toMovie(); m != nil {
+			result.Results = append(result.Results, m)
+		}
+	}
+	return result, nil
+}
+
+func (p *Provider) Recommendations(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	return p.relatedTitles(ctx, mediaType, id, "recommendations", page, language)
+}
+
+func (p *Provider) Similar(ctx context.Context, mediaType pb.MediaType, id int64, page int32, language string) (*provider.MoviePage, error) {
+	return p.relatedTitles(ctx, mediaType, id, "similar", page, language)
+}
+
+// relatedTitles запрашивает список тайтлов, связанных с тайтлом id:
+// /movie/{id}/{list} или /tv/{id}/{list}.
+func (p *Provider) relatedTitles(ctx context.Context, mediaType pb.MediaType, id int64, list string, page int32, language string) (*provider.MoviePage, error) {
+	params := url.Values{
+		"language": {language},
+		"page":     {fmt.Sprint(max(page, 1))},
+	}
+
+	switch mediaType {
+	case pb.MediaType_MEDIA_TYPE_MOVIE:
+		var tmdbResponse popularResponse
+		if err := p.get(ctx, fmt.Sprintf("/movie/%d/%s", id, list), params, &tmdbResponse); err != nil {
+			return nil, err
+		}
+		log.Printf("Получено %d фильмов (%s) от TMDb", len(tmdbResponse.Results), list)
+		return moviePage(tmdbResponse), nil
+
+	case pb.MediaType_MEDIA_TYPE_TV:
+		var tmdbResponse tvShowSearchResponse
+		if err := p.get(ctx, fmt.Sprintf("/tv/%d/%s", id, list), params, &tmdbResponse); err != nil {
+			return nil, err
+		}
+		log.Printf("Получено %d сериалов (%s) от TMDb", len(tmdbResponse.Results), list)
+		return tvShowPage(tmdbResponse), nil
+
+	default:
+		return nil, fmt.Errorf("список %s для типа %s не поддерживается", list, mediaType)
+	}
+}
@@ -0,0 +1,166 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type tvShowDetails struct {
+	tvShow
+	Tagline          string      `json:"tagline"`
+	Status           string      `json:"status"`
+	Genres           []genre     `json:"genres"`
+	EpisodeRunTime   []int32     `json:"episode_run_time"`
+	OriginalLanguage string      `json:"original_language"`
+	OriginCountry    []string    `json:"origin_country"`
+	Adult            bool        `json:"adult"`
+	VoteCount        int32       `json:"vote_count"`
+	LastAirDate      string      `json:"last_air_date"`
+	NumberOfSeasons  int32       `json:"number_of_seasons"`
+	NumberOfEpisodes int32       `json:"number_of_episodes"`
+	Seasons          []season    `json:"seasons"`
+	ExternalIDs      externalIDs `json:"external_ids"`
+	NextEpisodeToAir *episode    `json:"next_episode_to_air"`
+	LastEpisodeToAir *episode    `json:"last_episode_to_air"`
+	// У сериалов TMDb отдаёт альтернативные названия в results, а не в
+	// titles, как у фильмов.
+	AlternativeTitles struct {
+		Results []alternativeTitle `json:"results"`
+	} `json:"alternative_titles"`
+}
+
+type season struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Overview     string    `json:"overview"`
+	AirDate      string    `json:"air_date"`
+	PosterPath   string    `json:"poster_path"`
+	SeasonNumber int32     `json:"season_number"`
+	EpisodeCount int32     `json:"episode_count"`
+	Episodes     []episode `json:"episodes"`
+}
+
+type episode struct {
+	ID            int64   `json:"id"`
+	Name          string  `json:"name"`
+	Overview      string  `json:"overview"`
+	AirDate       string  `json:"air_date"`
+	SeasonNumber  int32   `json:"season_number"`
+	EpisodeNumber int32   `json:"episode_number"`
+	Runtime       int32   `json:"runtime"`
+	StillPath     string  `json:"still_path"`
+	VoteAverage   float64 `json:"vote_average"`
+}
+
+func (p *Provider) TVShowByID(ctx context.Context, id int64, language string) (*pb.TitleDetails, error) {
+	var tmdbResponse tvShowDetails
+	if err := p.get(ctx, fmt.Sprintf("/tv/%d", id), url.Values{
+		"language":           {language},
+		"append_to_response": {"external_ids,alternative_titles"},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получен сериал от TMDb: %s", tmdbResponse.Name)
+
+	var runtime int32
+	if len(tmdbResponse.EpisodeRunTime) > 0 {
+		runtime = tmdbResponse.EpisodeRunTime[0]
+	}
+
+	show := &pb.TitleDetails{
+		Id:               tmdbResponse.ID,
+		MediaType:        pb.MediaType_MEDIA_TYPE_TV,
+		Title:            tmdbResponse.Name,
+		OriginalTitle:    tmdbResponse.OriginalName,
+		Overview:         tmdbResponse.Overview,
+		Tagline:          tmdbResponse.Tagline,
+		Poster:           provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, tmdbResponse.PosterPath),
+		Backdrop:         provider.NewImage(pb.ImageKind_IMAGE_KIND_BACKDROP, tmdbResponse.BackdropPath),
+		ReleaseDate:      tmdbResponse.FirstAirDate,
+		Status:           tmdbResponse.Status,
+		Genres:           toGenres(tmdbResponse.Genres),
+		Runtime:          runtime,
+		OriginalLanguage: tmdbResponse.OriginalLanguage,
+		OriginCountry:    tmdbResponse.OriginCountry,
+		Adult:            tmdbResponse.Adult,
+		VoteAverage:      tmdbResponse.VoteAverage,
+		VoteCount:        tmdbResponse.VoteCount,
+		Popularity:       tmdbResponse.Popularity,
+		LastAirDate:      tmdbResponse.LastAirDate,
+		NumberOfSeasons:  tmdbResponse.NumberOfSeasons,
+		NumberOfEpisodes: tmdbResponse.NumberOfEpisodes,
+		ExternalIds:      tmdbResponse.ExternalIDs.toMap(tmdbResponse.ID),
+		AlternativeTitles: alternativeTitles(tmdbResponse.AlternativeTitles.Results,
+			tmdbResponse.Name, tmdbResponse.OriginalName),
+	}
+	for _, s := range tmdbResponse.Seasons {
+		show.Seasons = append(show.Seasons, toSeason(id, s))
+	}
+	if next := tmdbResponse.NextEpisodeToAir; next != nil {
+		show.NextEpisode = toAiringEpisode(tmdbResponse.tvShow, *next)
+	}
+	return show, nil
+}
+
+func (p *Provider) Season(ctx context.Context, tvID int64, seasonNumber int32, language string) (*pb.Season, error) {
+	var tmdbResponse season
+	if err := p.get(ctx, fmt.Sprintf("/tv/%d/season/%d", tvID, seasonNumber), url.Values{
+		"language": {language},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получен сезон %d сериала %d от TMDb: %d эпизодов", seasonNumber, tvID, len(tmdbResponse.Episodes))
+
+	result := toSeason(tvID, tmdbResponse)
+	result.EpisodeCount = int32(len(tmdbResponse.Episodes))
+	for _, e := range tmdbResponse.Episodes {
+		result.Episodes = append(result.Episodes, toEpisode(tvID, e))
+	}
+	return result, nil
+}
+
+func (p *Provider) Episode(ctx context.Context, tvID int64, seasonNumber, episodeNumber int32, language string) (*pb.Episode, error) {
+	var tmdbResponse episode
+	if err := p.get(ctx, fmt.Sprintf("/tv/%d/season/%d/episode/%d", tvID, seasonNumber, episodeNumber), url.Values{
+		"language": {language},
+	}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	return toEpisode(tvID, tmdbResponse), nil
+}
+
+func toSeason(tvID int64, s season) *pb.Season {
+	return &pb.Season{
+		Id:           s.ID,
+		TvId:         tvID,
+		SeasonNumber: s.SeasonNumber,
+		Name:         s.Name,
+		Overview:     s.Overview,
+		AirDate:      s.AirDate,
+		Poster:       provider.NewImage(pb.ImageKind_IMAGE_KIND_POSTER, s.PosterPath),
+		EpisodeCount: s.EpisodeCount,
+	}
+}
+
+func toEpisode(tvID int64, e episode) *pb.Episode {
+	return &pb.Episode{
+		Id:            e.ID,
+		TvId:          tvID,
+		SeasonNumber:  e.SeasonNumber,
+		EpisodeNumber: e.EpisodeNumber,
+		Name:          e.Name,
+		Overview:      e.Overview,
+		AirDate:       e.AirDate,
+		Runtime:       e.Runtime,
+		Still:         provider.NewImage(pb.ImageKind_IMAGE_KIND_STILL, e.StillPath),
+		VoteAverage:   e.VoteAverage,
+	}
+}
@@ -0,0 +1,66 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+type videosResponse struct {
+	Results []video `json:"results"`
+}
+
+type video struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Site        string `json:"site"`
+	Key         string `json:"key"`
+	ISO639      string `json:"iso_639_1"`
+	Official    bool   `json:"official"`
+	PublishedAt string `json:"published_at"`
+}
+
+// videoTypes переводит поле type TMDb в VideoType.
+var videoTypes = map[string]pb.VideoType{
+	"Trailer":           pb.VideoType_VIDEO_TYPE_TRAILER,
+	"Teaser":            pb.VideoType_VIDEO_TYPE_TEASER,
+	"Opening Credits":   pb.VideoType_VIDEO_TYPE_OPENING,
+	"Clip":              pb.VideoType_VIDEO_TYPE_CLIP,
+	"Featurette":        pb.VideoType_VIDEO_TYPE_FEATURETTE,
+	"Behind the Scenes": pb.VideoType_VIDEO_TYPE_BEHIND_THE_SCENES,
+	"Bloopers":          pb.VideoType_VIDEO_TYPE_BLOOPERS,
+}
+
+func (p *Provider) Videos(ctx context.Context, mediaType pb.MediaType, id int64, language string) ([]*pb.Video, error) {
+	path := fmt.Sprintf("/movie/%d/videos", id)
+	if mediaType == pb.MediaType_MEDIA_TYPE_TV {
+		path = fmt.Sprintf("/tv/%d/videos", id)
+	}
+	var tmdbResponse videosResponse
+	if err := p.get(ctx, path, url.Values{"language": {language}}, &tmdbResponse); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Получено %d видео тайтла %d от TMDb (%s)", len(tmdbResponse.Results), id, language)
+
+	var videos []*pb.Video
+	for _, v := range tmdbResponse.Results {
+		videos = append(videos, &pb.Video{
+			Id:          v.ID,
+			Name:        v.Name,
+			Type:        videoTypes[v.Type],
+			Site:        v.Site,
+			Key:         v.Key,
+			Url:         provider.VideoURL(v.Site, v.Key),
+			Language:    v.ISO639,
+			Official:    v.Official,
+			PublishedAt: v.PublishedAt,
+		})
+	}
+	return videos, nil
+}
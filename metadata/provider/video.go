@@ -0,0 +1,21 @@
+package provider
+
+import (
+	"net/url"
+	"strings"
+)
+
+// VideoURL возвращает ссылку на просмотр видео key на хостинге site или
+// пустую строку для неизвестного хостинга.
+func VideoURL(site, key string) string {
+	switch strings.ToLower(site) {
+	case "youtube":
+		return "https://www.youtube.com/watch?v=" + url.QueryEscape(key)
+	case "vimeo":
+		return "https://vimeo.com/" + url.PathEscape(key)
+	case "dailymotion":
+		return "https://www.dailymotion.com/video/" + url.PathEscape(key)
+	default:
+		return ""
+	}
+}
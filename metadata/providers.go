@@ -0,0 +1,98 @@
+package metadata
+
+import (
+	"context"
+	"log"
+
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcmetadata "google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// ProviderMetadataKey — ключ gRPC-метаданных, которым клиент выбирает
+// источник для отдельного запроса (например, "anilist"). Без него
+// используется источник, переданный в NewServer.
+const ProviderMetadataKey = "x-hikari-provider"
+
+type providerContextKey struct{}
+
+// WithProvider делает источник p доступным для выбора через
+// ProviderMetadataKey под именем p.Name().
+func WithProvider(p provider.Provider) Option {
+	return func(s *Server) {
+		s.providers[p.Name()] = p
+	}
+}
+
+// ProviderInterceptor возвращает серверный перехватчик, который выбирает
+// источник по ProviderMetadataKey и отмечает, какой источник ответил. Он
+// должен стоять в цепочке раньше CacheInterceptor и CatalogInterceptor:
+// ключи их записей зависят от выбранного источника.
+//
+// Если ответил не выбранный источник (запасной или источник пространства
+// имён ID), его имя возвращается клиенту в заголовке ProviderMetadataKey:
+// ID в таком ответе относятся к нему.
+func (s *Server) ProviderInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if info.Server != s {
+			return handler(ctx, req)
+		}
+
+		md, _ := grpcmetadata.FromIncomingContext(ctx)
+		if names := md.Get(ProviderMetadataKey); len(names) > 0 && names[0] != "" {
+			p, ok := s.providers[names[0]]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "неизвестный источник метаданных %q", names[0])
+			}
+			ctx = context.WithValue(ctx, providerContextKey{}, p)
+		}
+
+		ctx, origin := provider.WithOrigin(ctx)
+		resp, err := handler(ctx, req)
+		if name := origin.Name(); err == nil && name != "" && name != s.providerFor(ctx).Name() {
+			if err := grpc.SetHeader(ctx, grpcmetadata.Pairs(ProviderMetadataKey, name)); err != nil {
+				log.Printf("не удалось отметить источник ответа %s: %v", name, err)
+			}
+		}
+		return resp, err
+	}
+}
+
+// providerFor возвращает источник, выбранный для запроса.
+func (s *Server) providerFor(ctx context.Context) provider.Provider {
+	if p, ok := ctx.Value(providerContextKey{}).(provider.Provider); ok {
+		return p
+	}
+	return s.provider
+}
+
+// answeredBy возвращает имя источника, который ответил на запрос.
+func (s *Server) answeredBy(ctx context.Context) string {
+	if o := provider.OriginFrom(ctx); o != nil && o.Name() != "" {
+		return o.Name()
+	}
+	return s.providerFor(ctx).Name()
+}
+
+// servedByFallback сообщает, что ответ дал запасной источник вместо
+// основного. Такие ответы не сохраняются ни в кэш, ни в каталог: ID
+// запасного источника оказались бы там под именем основного.
+func servedByFallback(ctx context.Context) bool {
+	o := provider.OriginFrom(ctx)
+	return o != nil && o.Fallback()
+}
+
+// storable сообщает, можно ли сохранить ответ в кэш и каталог: ответы
+// запасного источника и неполные ответы не сохраняются, иначе они
+// отдавались бы вместо полных всё время жизни записи.
+func storable(ctx context.Context) bool {
+	o := provider.OriginFrom(ctx)
+	return o == nil || !o.Fallback() && !o.Partial()
+}
+
+// usesDefaultProvider сообщает, обслуживается ли запрос основным источником.
+func (s *Server) usesDefaultProvider(ctx context.Context) bool {
+	return s.providerFor(ctx).Name() == s.provider.Name()
+}
@@ -0,0 +1,206 @@
+package metadata
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"slices"
+	"sync"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// maxFranchiseSize ограничивает число тайтлов, которые обходятся при
+// построении порядка просмотра: у длинных франшиз связи ветвятся на
+// десятки спин-оффов.
+const maxFranchiseSize = 30
+
+// franchiseRelations — связи, по которым тайтлы считаются частью одной
+// франшизы. Спин-оффы, альтернативные версии и общие персонажи ведут к
+// другим историям и не обходятся.
+var franchiseRelations = map[pb.RelationType]bool{
+	pb.RelationType_RELATION_TYPE_SEQUEL:           true,
+	pb.RelationType_RELATION_TYPE_PREQUEL:          true,
+	pb.RelationType_RELATION_TYPE_PARENT:           true,
+	pb.RelationType_RELATION_TYPE_SIDE_STORY:       true,
+	pb.RelationType_RELATION_TYPE_SUMMARY:          true,
+	pb.RelationType_RELATION_TYPE_MOVIE_ADAPTATION: true,
+}
+
+// mainStoryRelations — связи основной сюжетной линии. Тайтлы, до которых
+// от запрошенного нельзя дойти только по ним, в порядке просмотра
+// помечаются необязательными.
+var mainStoryRelations = map[pb.RelationType]bool{
+	pb.RelationType_RELATION_TYPE_SEQUEL:  true,
+	pb.RelationType_RELATION_TYPE_PREQUEL: true,
+	pb.RelationType_RELATION_TYPE_PARENT:  true,
+}
+
+func (s *Server) GetRelations(ctx context.Context, req *pb.GetRelationsRequest) (*pb.RelationsResponse, error) {
+	p, id, err := s.titleProvider(ctx, req.GetMediaType(), req.GetTitleId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := p.Relations(ctx, req.GetMediaType(), id, req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	franchise := expandFranchise(ctx, p, root, req.GetLanguage())
+
+	return &pb.RelationsResponse{
+		Title:      root.Title,
+		Relations:  root.Relations,
+		WatchOrder: watchOrder(root, franchise),
+	}, nil
+}
+
+// titleKey различает тайтлы франшизы: у TMDb ID фильмов и сериалов
+// пересекаются.
+type titleKey struct {
+	mediaType pb.MediaType
+	id        int64
+}
+
+func keyOf(m *pb.Movie) titleKey {
+	return titleKey{m.GetMediaType(), m.GetId()}
+}
+
+// expandFranchise обходит франшизу в ширину от root по franchiseRelations
+// и возвращает связи всех найденных тайтлов, включая root. Тайтлы, связи
+// которых получить не удалось, остаются в графе без своих связей.
+func expandFranchise(ctx context.Context, p provider.Provider, root *provider.TitleRelations, language string) map[titleKey]*provider.TitleRelations {
+	franchise := map[titleKey]*provider.TitleRelations{keyOf(root.Title): root}
+	level := []*provider.TitleRelations{root}
+
+	for len(level) > 0 && len(franchise) < maxFranchiseSize {
+		var next []*pb.Movie
+		for _, node := range level {
+			for _, r := range node.Relations {
+				key := keyOf(r.GetTitle())
+				if !franchiseRelations[r.GetType()] || franchise[key] != nil {
+					continue
+				}
+				if len(franchise)+len(next) >= maxFranchiseSize {
+					break
+				}
+				franchise[key] = &provider.TitleRelations{Title: r.GetTitle(), Format: r.GetFormat()}
+				next = append(next, r.GetTitle())
+			}
+		}
+
+		fetched := make([]*provider.TitleRelations, len(next))
+		var wg sync.WaitGroup
+		for i, title := range next {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				relations, err := p.Relations(ctx, title.GetMediaType(), title.GetId(), language)
+				if err != nil {
+					log.Printf("не удалось получить связи тайтла %d: %v", title.GetId(), err)
+					return
+				}
+				fetched[i] = relations
+			}()
+		}
+		wg.Wait()
+
+		level = level[:0]
+		for _, relations := range fetched {
+			if relations != nil {
+				franchise[keyOf(relations.Title)] = relations
+				level = append(level, relations)
+			}
+		}
+	}
+	return franchise
+}
+
+// watchOrder упорядочивает франшизу: приквелы и основные истории идут
+// раньше сиквелов и побочных историй, а среди тайтлов, которые можно
+// смотреть в любом порядке, раньше идёт вышедший раньше.
+func watchOrder(root *provider.TitleRelations, franchise map[titleKey]*provider.TitleRelations) []*pb.WatchOrderEntry {
+	before := map[titleKey]map[titleKey]bool{} // before[b][a] — a смотреть раньше b
+	addEdge := func(first, then titleKey) {
+		if franchise[first] == nil || franchise[then] == nil || first == then {
+			return
+		}
+		if before[then] == nil {
+			before[then] = map[titleKey]bool{}
+		}
+		before[then][first] = true
+	}
+	for key, node := range franchise {
+		for _, r := range node.Relations {
+			other := keyOf(r.GetTitle())
+			switch r.GetType() {
+			case pb.RelationType_RELATION_TYPE_SEQUEL, pb.RelationType_RELATION_TYPE_SIDE_STORY,
+				pb.RelationType_RELATION_TYPE_SUMMARY, pb.RelationType_RELATION_TYPE_MOVIE_ADAPTATION:
+				addEdge(key, other)
+			case pb.RelationType_RELATION_TYPE_PREQUEL, pb.RelationType_RELATION_TYPE_PARENT:
+				addEdge(other, key)
+			}
+		}
+	}
+
+	mainStory := reachable(keyOf(root.Title), franchise, mainStoryRelations)
+
+	remaining := make([]titleKey, 0, len(franchise))
+	for key := range franchise {
+		remaining = append(remaining, key)
+	}
+	slices.SortFunc(remaining, func(a, b titleKey) int {
+		ta, tb := franchise[a].Title, franchise[b].Title
+		return cmp.Or(
+			cmp.Compare(cmp.Or(ta.GetReleaseDate(), "9999"), cmp.Or(tb.GetReleaseDate(), "9999")),
+			cmp.Compare(a.mediaType, b.mediaType),
+			cmp.Compare(a.id, b.id),
+		)
+	})
+
+	order := make([]*pb.WatchOrderEntry, 0, len(franchise))
+	watched := map[titleKey]bool{}
+	for len(remaining) > 0 {
+		// Первый по дате тайтл, всё предшествующее которому уже в списке.
+		// Если таких нет (связи образуют цикл), берётся просто первый по дате.
+		next := slices.IndexFunc(remaining, func(key titleKey) bool {
+			for first := range before[key] {
+				if !watched[first] {
+					return false
+				}
+			}
+			return true
+		})
+		next = max(next, 0)
+
+		key := remaining[next]
+		remaining = slices.Delete(remaining, next, next+1)
+		watched[key] = true
+		order = append(order, &pb.WatchOrderEntry{
+			Title:    franchise[key].Title,
+			Format:   franchise[key].Format,
+			Optional: !mainStory[key],
+		})
+	}
+	return order
+}
+
+// reachable возвращает тайтлы, до которых от start можно дойти по связям
+// типов relations.
+func reachable(start titleKey, franchise map[titleKey]*provider.TitleRelations, relations map[pb.RelationType]bool) map[titleKey]bool {
+	seen := map[titleKey]bool{start: true}
+	queue := []titleKey{start}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		for _, r := range franchise[key].Relations {
+			other := keyOf(r.GetTitle())
+			if relations[r.GetType()] && franchise[other] != nil && !seen[other] {
+				seen[other] = true
+				queue = append(queue, other)
+			}
+		}
+	}
+	return seen
+}
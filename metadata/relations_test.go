@@ -0,0 +1,128 @@
+package metadata
+
+import (
+	"slices"
+	"testing"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+)
+
+// relation — связь from → to типа kind в тестовой франшизе.
+type relation struct {
+	from, to int64
+	kind     pb.RelationType
+}
+
+// franchiseOf строит франшизу из сериалов с датами выхода dates (по ID)
+// и связей relations.
+func franchiseOf(dates map[int64]string, relations []relation) map[titleKey]*provider.TitleRelations {
+	franchise := map[titleKey]*provider.TitleRelations{}
+	title := func(id int64) *pb.Movie {
+		return &pb.Movie{Id: id, MediaType: pb.MediaType_MEDIA_TYPE_TV, ReleaseDate: dates[id]}
+	}
+	for id := range dates {
+		franchise[keyOf(title(id))] = &provider.TitleRelations{Title: title(id)}
+	}
+	for _, r := range relations {
+		node := franchise[keyOf(title(r.from))]
+		node.Relations = append(node.Relations, &pb.Relation{Type: r.kind, Title: title(r.to)})
+	}
+	return franchise
+}
+
+func TestWatchOrder(t *testing.T) {
+	const (
+		sequel    = pb.RelationType_RELATION_TYPE_SEQUEL
+		prequel   = pb.RelationType_RELATION_TYPE_PREQUEL
+		sideStory = pb.RelationType_RELATION_TYPE_SIDE_STORY
+		parent    = pb.RelationType_RELATION_TYPE_PARENT
+		spinOff   = pb.RelationType_RELATION_TYPE_SPIN_OFF
+	)
+
+	tests := []struct {
+		name      string
+		root      int64
+		dates     map[int64]string
+		relations []relation
+		want      []int64
+		optional  []int64
+	}{
+		{
+			name:      "сиквелы по порядку",
+			root:      1,
+			dates:     map[int64]string{1: "2013-04-07", 2: "2017-04-01", 3: "2018-07-23"},
+			relations: []relation{{1, 2, sequel}, {2, 3, sequel}},
+			want:      []int64{1, 2, 3},
+		},
+		{
+			name:      "от сиквела через приквел",
+			root:      2,
+			dates:     map[int64]string{1: "2013-04-07", 2: "2017-04-01"},
+			relations: []relation{{2, 1, prequel}},
+			want:      []int64{1, 2},
+		},
+		{
+			name:      "связи важнее дат",
+			root:      1,
+			dates:     map[int64]string{1: "2020-01-01", 2: "2019-01-01"},
+			relations: []relation{{1, 2, sequel}},
+			want:      []int64{1, 2},
+		},
+		{
+			name:  "побочная история необязательна и идёт по дате",
+			root:  1,
+			dates: map[int64]string{1: "2013-04-07", 2: "2017-04-01", 3: "2014-12-09"},
+			relations: []relation{
+				{1, 2, sequel},
+				{1, 3, sideStory},
+				{3, 1, parent},
+			},
+			want:     []int64{1, 3, 2},
+			optional: []int64{3},
+		},
+		{
+			name:      "спин-офф не задаёт порядок",
+			root:      1,
+			dates:     map[int64]string{1: "2015-01-01", 2: "2010-01-01"},
+			relations: []relation{{1, 2, spinOff}},
+			want:      []int64{2, 1},
+			optional:  []int64{2},
+		},
+		{
+			name:      "цикл связей разрывается по дате",
+			root:      1,
+			dates:     map[int64]string{1: "2013-04-07", 2: "2017-04-01"},
+			relations: []relation{{1, 2, sequel}, {2, 1, sequel}},
+			want:      []int64{1, 2},
+		},
+		{
+			name:     "тайтлы без даты в конце",
+			root:     1,
+			dates:    map[int64]string{1: "", 2: "2017-04-01", 3: "2013-04-07"},
+			want:     []int64{3, 2, 1},
+			optional: []int64{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			franchise := franchiseOf(tt.dates, tt.relations)
+			root := franchise[titleKey{pb.MediaType_MEDIA_TYPE_TV, tt.root}]
+
+			var got, optional []int64
+			for _, e := range watchOrder(root, franchise) {
+				got = append(got, e.GetTitle().GetId())
+				if e.GetOptional() {
+					optional = append(optional, e.GetTitle().GetId())
+				}
+			}
+			slices.Sort(optional)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("порядок = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(optional, tt.optional) {
+				t.Errorf("необязательные = %v, want %v", optional, tt.optional)
+			}
+		})
+	}
+}
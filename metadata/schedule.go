@@ -0,0 +1,111 @@
+package metadata
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultScheduleDays — длина расписания, если период не задан.
+	defaultScheduleDays = 7
+	// maxScheduleDays ограничивает запрашиваемый период.
+	maxScheduleDays = 31
+)
+
+func (s *Server) GetAiringSchedule(ctx context.Context, req *pb.GetAiringScheduleRequest) (*pb.AiringScheduleResponse, error) {
+	loc, from, to, err := scheduleWindow(req, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.providerFor(ctx).AiringSchedule(ctx, from, to, max(req.GetPage(), 1), req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range page.Results {
+		setAirsAt(e, loc)
+	}
+
+	return &pb.AiringScheduleResponse{
+		Results:      page.Results,
+		Timezone:     loc.String(),
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}, nil
+}
+
+// scheduleWindow возвращает часовой пояс и промежуток [from, to) запроса
+// расписания: без from расписание начинается с текущего дня now в этом
+// часовом поясе, без to длится defaultScheduleDays дней.
+func scheduleWindow(req *pb.GetAiringScheduleRequest, now time.Time) (loc *time.Location, from, to time.Time, err error) {
+	if loc, err = location(req.GetTimezone()); err != nil {
+		return nil, from, to, err
+	}
+
+	now = now.In(loc)
+	from = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if req.GetFrom() != "" {
+		if from, err = time.ParseInLocation(time.DateOnly, req.GetFrom(), loc); err != nil {
+			return nil, from, to, status.Errorf(codes.InvalidArgument, "некорректная дата from: %q", req.GetFrom())
+		}
+	}
+	to = from.AddDate(0, 0, defaultScheduleDays)
+	if req.GetTo() != "" {
+		last, err := time.ParseInLocation(time.DateOnly, req.GetTo(), loc)
+		if err != nil {
+			return nil, from, to, status.Errorf(codes.InvalidArgument, "некорректная дата to: %q", req.GetTo())
+		}
+		to = last.AddDate(0, 0, 1)
+	}
+	if !to.After(from) {
+		return nil, from, to, status.Errorf(codes.InvalidArgument, "дата to не может быть раньше from")
+	}
+	if to.After(from.AddDate(0, 0, maxScheduleDays)) {
+		return nil, from, to, status.Errorf(codes.InvalidArgument, "период расписания не может превышать %d дней", maxScheduleDays)
+	}
+	return loc, from, to, nil
+}
+
+// resolvedSchedule возвращает копию запроса расписания с явными датами
+// from и to, чтобы кэш и каталог хранили расписание «на сегодня» под
+// датами, на которые оно получено. Некорректный запрос возвращается как
+// есть: на него ответит ошибкой обработчик.
+func resolvedSchedule(req *pb.GetAiringScheduleRequest, now time.Time) *pb.GetAiringScheduleRequest {
+	_, from, to, err := scheduleWindow(req, now)
+	if err != nil {
+		return req
+	}
+	resolved := proto.Clone(req).(*pb.GetAiringScheduleRequest)
+	resolved.From = from.Format(time.DateOnly)
+	resolved.To = to.AddDate(0, 0, -1).Format(time.DateOnly)
+	return resolved
+}
+
+// location загружает часовой пояс IANA; пустое имя — UTC.
+func location(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "неизвестный часовой пояс (timezone): %q", name)
+	}
+	return loc, nil
+}
+
+// setAirsAt заполняет airs_at временем выхода в часовом поясе loc. Для
+// эпизодов, у которых известна только дата, часовой пояс не применяется.
+func setAirsAt(e *pb.AiringEpisode, loc *time.Location) {
+	if e == nil || e.GetAirsAtUnix() == 0 {
+		return
+	}
+	airsAt := time.Unix(e.GetAirsAtUnix(), 0)
+	if e.GetDateOnly() {
+		e.AirsAt = airsAt.UTC().Format(time.DateOnly)
+		return
+	}
+	e.AirsAt = airsAt.In(loc).Format(time.RFC3339)
+}
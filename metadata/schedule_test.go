@@ -0,0 +1,116 @@
+package metadata
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestScheduleWindow(t *testing.T) {
+	// 22:00 UTC — в Токио уже следующий день.
+	now := time.Date(2024, 4, 1, 22, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		req              *pb.GetAiringScheduleRequest
+		wantLoc          string
+		wantFrom, wantTo string
+		wantErr          bool
+	}{
+		{
+			name:    "по умолчанию неделя с сегодняшнего дня в UTC",
+			req:     &pb.GetAiringScheduleRequest{},
+			wantLoc: "UTC", wantFrom: "2024-04-01T00:00:00Z", wantTo: "2024-04-08T00:00:00Z",
+		},
+		{
+			name:    "сегодня считается в часовом поясе запроса",
+			req:     &pb.GetAiringScheduleRequest{Timezone: "Asia/Tokyo"},
+			wantLoc: "Asia/Tokyo", wantFrom: "2024-04-02T00:00:00+09:00", wantTo: "2024-04-09T00:00:00+09:00",
+		},
+		{
+			name:    "только from",
+			req:     &pb.GetAiringScheduleRequest{From: "2024-05-10", Timezone: "Europe/Moscow"},
+			wantLoc: "Europe/Moscow", wantFrom: "2024-05-10T00:00:00+03:00", wantTo: "2024-05-17T00:00:00+03:00",
+		},
+		{
+			name:    "to включается целиком",
+			req:     &pb.GetAiringScheduleRequest{From: "2024-05-10", To: "2024-05-10"},
+			wantLoc: "UTC", wantFrom: "2024-05-10T00:00:00Z", wantTo: "2024-05-11T00:00:00Z",
+		},
+		{
+			name:    "только to",
+			req:     &pb.GetAiringScheduleRequest{To: "2024-04-03"},
+			wantLoc: "UTC", wantFrom: "2024-04-01T00:00:00Z", wantTo: "2024-04-04T00:00:00Z",
+		},
+		{
+			name:    "наибольший период",
+			req:     &pb.GetAiringScheduleRequest{From: "2024-01-01", To: "2024-01-31"},
+			wantLoc: "UTC", wantFrom: "2024-01-01T00:00:00Z", wantTo: "2024-02-01T00:00:00Z",
+		},
+		{name: "период длиннее maxScheduleDays", req: &pb.GetAiringScheduleRequest{From: "2024-01-01", To: "2024-02-01"}, wantErr: true},
+		{name: "to раньше from", req: &pb.GetAiringScheduleRequest{From: "2024-05-10", To: "2024-05-09"}, wantErr: true},
+		{name: "некорректный from", req: &pb.GetAiringScheduleRequest{From: "10.05.2024"}, wantErr: true},
+		{name: "некорректный to", req: &pb.GetAiringScheduleRequest{To: "2024-13-01"}, wantErr: true},
+		{name: "неизвестный часовой пояс", req: &pb.GetAiringScheduleRequest{Timezone: "Mars/Olympus"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, from, to, err := scheduleWindow(tt.req, now)
+			if tt.wantErr {
+				if status.Code(err) != codes.InvalidArgument {
+					t.Fatalf("ошибка = %v, want InvalidArgument", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if loc.String() != tt.wantLoc {
+				t.Errorf("часовой пояс = %s, want %s", loc, tt.wantLoc)
+			}
+			if got := from.Format(time.RFC3339); got != tt.wantFrom {
+				t.Errorf("from = %s, want %s", got, tt.wantFrom)
+			}
+			if got := to.Format(time.RFC3339); got != tt.wantTo {
+				t.Errorf("to = %s, want %s", got, tt.wantTo)
+			}
+		})
+	}
+}
+
+func TestResolvedSchedule(t *testing.T) {
+	now := time.Date(2024, 4, 1, 22, 0, 0, 0, time.UTC)
+
+	got := resolvedSchedule(&pb.GetAiringScheduleRequest{Timezone: "Asia/Tokyo", Page: 2}, now)
+	if got.GetFrom() != "2024-04-02" || got.GetTo() != "2024-04-08" || got.GetPage() != 2 {
+		t.Errorf("resolvedSchedule = %v", got)
+	}
+
+	bad := &pb.GetAiringScheduleRequest{From: "вчера"}
+	if got := resolvedSchedule(bad, now); got != bad {
+		t.Errorf("некорректный запрос изменён: %v", got)
+	}
+}
+
+func TestSetAirsAt(t *testing.T) {
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+	airsAt := time.Date(2024, 4, 6, 23, 30, 0, 0, time.UTC).Unix()
+
+	timed := &pb.AiringEpisode{AirsAtUnix: airsAt}
+	setAirsAt(timed, moscow)
+	if timed.GetAirsAt() != "2024-04-07T02:30:00+03:00" {
+		t.Errorf("AirsAt = %q", timed.GetAirsAt())
+	}
+
+	dateOnly := &pb.AiringEpisode{AirsAtUnix: time.Date(2024, 4, 6, 0, 0, 0, 0, time.UTC).Unix(), DateOnly: true}
+	setAirsAt(dateOnly, moscow)
+	if dateOnly.GetAirsAt() != "2024-04-06" {
+		t.Errorf("AirsAt эпизода без времени = %q, want 2024-04-06", dateOnly.GetAirsAt())
+	}
+}
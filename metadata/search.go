@@ -0,0 +1,298 @@
+package metadata
+
+import (
+	"cmp"
+	"context"
+	"log"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// multiSearchPageSize — размер страницы объединённой выдачи.
+	multiSearchPageSize = 20
+	// multiSearchUpstreamPages — сколько страниц запрашивается у каждого
+	// источника. Выдача ранжируется целиком в этом окне, поэтому порядок
+	// не меняется при переходе между страницами.
+	multiSearchUpstreamPages = 3
+)
+
+// multiSearchMergedRPC — имя, под которым в кэше хранится вся ранжированная
+// выдача для запроса, чтобы следующие страницы не повторяли поиск.
+const multiSearchMergedRPC = "MultiSearch/merged"
+
+func (s *Server) MultiSearch(ctx context.Context, req *pb.MultiSearchRequest) (*pb.MultiSearchResponse, error) {
+	query := strings.TrimSpace(req.GetQuery())
+	if query == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "поисковый запрос (query) не может быть пустым")
+	}
+	page := max(req.GetPage(), 1)
+
+	types := map[pb.MediaType]bool{}
+	for _, t := range req.GetMediaTypes() {
+		if t == pb.MediaType_MEDIA_TYPE_UNSPECIFIED {
+			return nil, status.Errorf(codes.InvalidArgument, "некорректный тип контента в media_types")
+		}
+		types[t] = true
+	}
+	if len(types) == 0 {
+		types = map[pb.MediaType]bool{
+			pb.MediaType_MEDIA_TYPE_MOVIE:  true,
+			pb.MediaType_MEDIA_TYPE_TV:     true,
+			pb.MediaType_MEDIA_TYPE_PERSON: true,
+		}
+	}
+
+	merged, err := s.mergedSearch(ctx, query, req.GetLanguage(), types)
+	if err != nil {
+		return nil, err
+	}
+
+	total := int32(len(merged.GetResults()))
+	start := min(int((page-1)*multiSearchPageSize), len(merged.GetResults()))
+	end := min(start+multiSearchPageSize, len(merged.GetResults()))
+
+	return &pb.MultiSearchResponse{
+		Results:      merged.GetResults()[start:end],
+		Page:         page,
+		TotalPages:   (total + multiSearchPageSize - 1) / multiSearchPageSize,
+		TotalResults: total,
+	}, nil
+}
+
+// mergedSearch возвращает всю ранжированную выдачу по query, по
+// возможности из кэша. Неполная выдача (часть поисков не ответила) и
+// выдача запасного источника не кэшируются.
+func (s *Server) mergedSearch(ctx context.Context, query, language string, types map[pb.MediaType]bool) (*pb.MultiSearchResponse, error) {
+	var key cache.Key
+	if s.cache != nil {
+		keyReq := &pb.MultiSearchRequest{Query: query, Language: language}
+		for _, t := range []pb.MediaType{pb.MediaType_MEDIA_TYPE_MOVIE, pb.MediaType_MEDIA_TYPE_TV, pb.MediaType_MEDIA_TYPE_PERSON} {
+			if types[t] {
+				keyReq.MediaTypes = append(keyReq.MediaTypes, t)
+			}
+		}
+		key, _ = s.cacheKey(ctx, multiSearchMergedRPC, keyReq)
+		if cached, ok := s.cache.Get(key); ok {
+			return cached.(*pb.MultiSearchResponse), nil
+		}
+	}
+
+	hits, err := s.collectSearchHits(ctx, query, language, types)
+	if err != nil {
+		return nil, err
+	}
+	rankHits(query, hits)
+
+	merged := &pb.MultiSearchResponse{Results: hits}
+	if s.cache != nil && storable(ctx) {
+		s.cache.Set(key, merged)
+	}
+	return merged, nil
+}
+
+// collectSearchHits параллельно запрашивает у источника первые
+// multiSearchUpstreamPages страниц по каждому типу контента и убирает
+// повторы. Ошибка возвращается, только если не ответил ни один поиск;
+// если не ответила часть, выдача отмечается неполной.
+func (s *Server) collectSearchHits(ctx context.Context, query, language string, types map[pb.MediaType]bool) ([]*pb.SearchHit, error) {
+	type fetchPage func(page int32) ([]*pb.SearchHit, int32, error)
+
+	sources := map[pb.MediaType]fetchPage{
+		pb.MediaType_MEDIA_TYPE_MOVIE: func(page int32) ([]*pb.SearchHit, int32, error) {
+			result, err := s.providerFor(ctx).SearchMovies(ctx, query, page, language)
+			if err != nil {
+				return nil, 0, err
+			}
+			return titleHits(result.Results, pb.MediaType_MEDIA_TYPE_MOVIE), result.TotalPages, nil
+		},
+		pb.MediaType_MEDIA_TYPE_TV: func(page int32) ([]*pb.SearchHit, int32, error) {
+			result, err := s.providerFor(ctx).SearchTVShows(ctx, query, page, language)
+			if err != nil {
+				return nil, 0, err
+			}
+			return titleHits(result.Results, pb.MediaType_MEDIA_TYPE_TV), result.TotalPages, nil
+		},
+		pb.MediaType_MEDIA_TYPE_PERSON: func(page int32) ([]*pb.SearchHit, int32, error) {
+			result, err := s.providerFor(ctx).SearchPeople(ctx, query, page, language)
+			if err != nil {
+				return nil, 0, err
+			}
+			var hits []*pb.SearchHit
+			for _, person := range result.Results {
+				hits = append(hits, &pb.SearchHit{MediaType: pb.MediaType_MEDIA_TYPE_PERSON, Person: person})
+			}
+			return hits, result.TotalPages, nil
+		},
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		hits     []*pb.SearchHit
+		lastErr  error
+		failures int
+		partial  bool
+	)
+	for mediaType, fetch := range sources {
+		if !types[mediaType] {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for page := int32(1); page <= multiSearchUpstreamPages; page++ {
+				pageHits, totalPages, err := fetch(page)
+				mu.Lock()
+				if err != nil {
+					log.Printf("ошибка поиска %s (страница %d): %v", mediaType, page, err)
+					partial = true
+					if page == 1 {
+						failures++
+						lastErr = err
+					}
+				}
+				hits = append(hits, pageHits...)
+				mu.Unlock()
+
+				if err != nil || page >= totalPages {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if failures == len(types) {
+		return nil, lastErr
+	}
+	if partial {
+		provider.ReportPartial(ctx)
+	}
+	return dedupHits(hits), nil
+}
+
+func titleHits(titles []*pb.Movie, mediaType pb.MediaType) []*pb.SearchHit {
+	var hits []*pb.SearchHit
+	for _, title := range titles {
+		if title.GetMediaType() == pb.MediaType_MEDIA_TYPE_UNSPECIFIED {
+			title = proto.CloneOf(title)
+			title.MediaType = mediaType
+		}
+		hits = append(hits, &pb.SearchHit{MediaType: mediaType, Title: title})
+	}
+	return hits
+}
+
+func dedupHits(hits []*pb.SearchHit) []*pb.SearchHit {
+	type hitKey struct {
+		mediaType pb.MediaType
+		id        int64
+	}
+
+	seen := make(map[hitKey]bool, len(hits))
+	unique := hits[:0]
+	for _, hit := range hits {
+		key := hitKey{hit.GetMediaType(), hitID(hit)}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, hit)
+	}
+	return unique
+}
+
+// rankHits проставляет score и сортирует выдачу: в первую очередь по
+// совпадению названия с запросом, затем по популярности.
+func rankHits(query string, hits []*pb.SearchHit) {
+	normalizedQuery := normalizeTitle(query)
+	for _, hit := range hits {
+		relevance := 0.0
+		for _, name := range hitNames(hit) {
+			relevance = max(relevance, titleRelevance(normalizedQuery, normalizeTitle(name)))
+		}
+		hit.Score = 0.7*relevance + 0.3*popularityScore(hitPopularity(hit))
+	}
+
+	slices.SortStableFunc(hits, func(a, b *pb.SearchHit) int {
+		return cmp.Or(
+			cmp.Compare(b.GetScore(), a.GetScore()),
+			cmp.Compare(hitPopularity(b), hitPopularity(a)),
+			cmp.Compare(hitID(a), hitID(b)),
+		)
+	})
+}
+
+// titleRelevance оценивает совпадение названия с запросом от 0 до 1.
+func titleRelevance(query, title string) float64 {
+	switch {
+	case query == "" || title == "":
+		return 0
+	case title == query:
+		return 1
+	case strings.HasPrefix(title, query):
+		return 0.85
+	case strings.Contains(title, query):
+		return 0.7
+	}
+
+	queryWords := strings.Fields(query)
+	titleWords := strings.Fields(title)
+	matched := 0
+	for _, qw := range queryWords {
+		for _, tw := range titleWords {
+			if strings.HasPrefix(tw, qw) {
+				matched++
+				break
+			}
+		}
+	}
+	return 0.6 * float64(matched) / float64(len(queryWords))
+}
+
+// popularityScore сжимает популярность TMDb (от 0 до тысяч) в [0, 1].
+func popularityScore(popularity float64) float64 {
+	return min(math.Log1p(max(popularity, 0))/math.Log1p(1000), 1)
+}
+
+// normalizeTitle приводит название к нижнему регистру и заменяет
+// знаки препинания пробелами.
+func normalizeTitle(title string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	}), " ")
+}
+
+func hitNames(hit *pb.SearchHit) []string {
+	if hit.GetPerson() != nil {
+		return []string{hit.GetPerson().GetName()}
+	}
+	return []string{hit.GetTitle().GetTitle(), hit.GetTitle().GetOriginalTitle()}
+}
+
+func hitPopularity(hit *pb.SearchHit) float64 {
+	if hit.GetPerson() != nil {
+		return hit.GetPerson().GetPopularity()
+	}
+	return hit.GetTitle().GetPopularity()
+}
+
+func hitID(hit *pb.SearchHit) int64 {
+	if hit.GetPerson() != nil {
+		return hit.GetPerson().GetId()
+	}
+	return hit.GetTitle().GetId()
+}
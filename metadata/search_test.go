@@ -0,0 +1,246 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"github.com/waste3d/Hikari-Anime/metadata/provider/fake"
+	"google.golang.org/protobuf/proto"
+)
+
+func titleHit(mediaType pb.MediaType, id int64, title string, popularity float64) *pb.SearchHit {
+	return &pb.SearchHit{
+		MediaType: mediaType,
+		Title:     &pb.Movie{Id: id, Title: title, MediaType: mediaType, Popularity: popularity},
+	}
+}
+
+func personHit(id int64, name string, popularity float64) *pb.SearchHit {
+	return &pb.SearchHit{
+		MediaType: pb.MediaType_MEDIA_TYPE_PERSON,
+		Person:    &pb.PersonSummary{Id: id, Name: name, Popularity: popularity},
+	}
+}
+
+func hitIDs(hits []*pb.SearchHit) []int64 {
+	ids := make([]int64, len(hits))
+	for i, hit := range hits {
+		ids[i] = hitID(hit)
+	}
+	return ids
+}
+
+func TestRankHits(t *testing.T) {
+	const tv = pb.MediaType_MEDIA_TYPE_TV
+
+	tests := []struct {
+		name  string
+		query string
+		hits  []*pb.SearchHit
+		want  []int64
+	}{
+		{
+			name:  "точное совпадение выше популярного продолжения",
+			query: "Naruto",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "Naruto Shippuden", 500),
+				titleHit(tv, 2, "Naruto", 50),
+			},
+			want: []int64{2, 1},
+		},
+		{
+			name:  "регистр и знаки препинания не важны",
+			query: "steins gate",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "Gate", 100),
+				titleHit(tv, 2, "Steins;Gate", 10),
+			},
+			want: []int64{2, 1},
+		},
+		{
+			name:  "оригинальное название",
+			query: "shingeki no kyojin",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "Kyojin", 100),
+				{MediaType: tv, Title: &pb.Movie{Id: 2, Title: "Attack on Titan", OriginalTitle: "Shingeki no Kyojin", Popularity: 100}},
+			},
+			want: []int64{2, 1},
+		},
+		{
+			name:  "при равном совпадении выше популярный",
+			query: "one piece",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "One Piece", 10),
+				titleHit(pb.MediaType_MEDIA_TYPE_MOVIE, 2, "One Piece", 200),
+			},
+			want: []int64{2, 1},
+		},
+		{
+			name:  "люди ранжируются по имени",
+			query: "hayao miyazaki",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "Miyazaki", 50),
+				personHit(2, "Hayao Miyazaki", 20),
+			},
+			want: []int64{2, 1},
+		},
+		{
+			name:  "без совпадения порядок по популярности, затем по ID",
+			query: "xyz",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 3, "B", 1),
+				titleHit(tv, 2, "A", 5),
+				titleHit(tv, 1, "C", 1),
+			},
+			want: []int64{2, 1, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rankHits(tt.query, tt.hits)
+			if got := hitIDs(tt.hits); !slices.Equal(got, tt.want) {
+				t.Errorf("порядок = %v, want %v", got, tt.want)
+			}
+			for _, hit := range tt.hits {
+				if hit.GetScore() < 0 || hit.GetScore() > 1 {
+					t.Errorf("score %d = %v, want [0, 1]", hitID(hit), hit.GetScore())
+				}
+			}
+		})
+	}
+}
+
+func TestDedupHits(t *testing.T) {
+	const (
+		movie = pb.MediaType_MEDIA_TYPE_MOVIE
+		tv    = pb.MediaType_MEDIA_TYPE_TV
+	)
+
+	tests := []struct {
+		name string
+		hits []*pb.SearchHit
+		want []*pb.SearchHit
+	}{
+		{
+			name: "пусто",
+		},
+		{
+			name: "повтор убирается, первый остаётся",
+			hits: []*pb.SearchHit{
+				titleHit(tv, 1, "первый", 0),
+				titleHit(tv, 2, "другой", 0),
+				titleHit(tv, 1, "повтор", 0),
+			},
+			want: []*pb.SearchHit{
+				titleHit(tv, 1, "первый", 0),
+				titleHit(tv, 2, "другой", 0),
+			},
+		},
+		{
+			name: "одинаковый ID у разных типов — разные тайтлы",
+			hits: []*pb.SearchHit{
+				titleHit(movie, 1, "фильм", 0),
+				titleHit(tv, 1, "сериал", 0),
+				personHit(1, "человек", 0),
+			},
+			want: []*pb.SearchHit{
+				titleHit(movie, 1, "фильм", 0),
+				titleHit(tv, 1, "сериал", 0),
+				personHit(1, "человек", 0),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dedupHits(tt.hits)
+			if len(got) != len(tt.want) {
+				t.Fatalf("получено %d результатов, want %d", len(got), len(tt.want))
+			}
+			for i := range got {
+				if !proto.Equal(got[i], tt.want[i]) {
+					t.Errorf("результат %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// failingSearch — fake-источник, у которого не работает поиск типов из
+// failing.
+type failingSearch struct {
+	*fake.Provider
+	name    string
+	failing map[pb.MediaType]bool
+}
+
+func (p *failingSearch) Name() string {
+	return p.name
+}
+
+func (p *failingSearch) SearchMovies(ctx context.Context, query string, page int32, language string) (*provider.MoviePage, error) {
+	if p.failing[pb.MediaType_MEDIA_TYPE_MOVIE] {
+		return nil, provider.NewError(p.name, provider.KindUnavailable, errors.New("поиск недоступен"))
+	}
+	return p.Provider.SearchMovies(ctx, query, page, language)
+}
+
+func (p *failingSearch) SearchPeople(ctx context.Context, query string, page int32, language string) (*provider.PersonPage, error) {
+	if p.failing[pb.MediaType_MEDIA_TYPE_PERSON] {
+		return nil, provider.NewError(p.name, provider.KindUnavailable, errors.New("поиск недоступен"))
+	}
+	return p.Provider.SearchPeople(ctx, query, page, language)
+}
+
+func TestMergedSearchCaching(t *testing.T) {
+	const (
+		movie  = pb.MediaType_MEDIA_TYPE_MOVIE
+		person = pb.MediaType_MEDIA_TYPE_PERSON
+	)
+
+	tests := []struct {
+		name       string
+		provider   provider.Provider
+		wantCached bool
+	}{
+		{
+			name:       "полная выдача кэшируется",
+			provider:   &failingSearch{Provider: fake.New(), name: "fake"},
+			wantCached: true,
+		},
+		{
+			name:     "неполная выдача не кэшируется",
+			provider: &failingSearch{Provider: fake.New(), name: "fake", failing: map[pb.MediaType]bool{person: true}},
+		},
+		{
+			name: "выдача запасного источника не кэшируется",
+			provider: provider.NewFallback(
+				&failingSearch{Provider: fake.New(), name: "primary", failing: map[pb.MediaType]bool{movie: true}},
+				fake.New(),
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := cache.New(cache.Config{DefaultTTL: time.Hour})
+			s := NewServer(tt.provider, WithCache(c))
+			ctx, _ := provider.WithOrigin(context.Background())
+
+			resp, err := s.MultiSearch(ctx, &pb.MultiSearchRequest{Query: "а", MediaTypes: []pb.MediaType{movie, person}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp.GetResults()) == 0 {
+				t.Fatal("пустая выдача")
+			}
+			if cached := c.Stats().Entries > 0; cached != tt.wantCached {
+				t.Errorf("выдача в кэше = %v, want %v", cached, tt.wantCached)
+			}
+		})
+	}
+}
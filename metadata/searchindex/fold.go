@@ -0,0 +1,147 @@
+package searchindex
+
+import (
+	"strings"
+	"unicode"
+)
+
+// cyrillic — транслитерация русских букв. Она близка к системе
+// Поливанова, которой записывают японские названия по-русски, чтобы
+// «Кёдзин» и «Kyojin» сводились к одному ключу после romaji.
+var cyrillic = map[rune]string{
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "yo",
+	'ж': "zh", 'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m",
+	'н': "n", 'о': "o", 'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u",
+	'ф': "f", 'х': "kh", 'ц': "ts", 'ч': "ch", 'ш': "sh", 'щ': "shch",
+	'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "yu", 'я': "ya",
+	'і': "i", 'ї': "i", 'є': "e", 'ў': "u",
+}
+
+// diacritics — латинские буквы с диакритикой, в том числе макроны
+// ромадзи: «Kyōto» ищется как «Kyoto».
+var diacritics = map[rune]string{
+	'ā': "a", 'á': "a", 'à': "a", 'â': "a", 'ä': "a", 'ã': "a", 'å': "a",
+	'ē': "e", 'é': "e", 'è': "e", 'ê': "e", 'ë': "e",
+	'ī': "i", 'í': "i", 'ì': "i", 'î': "i", 'ï': "i",
+	'ō': "o", 'ó': "o", 'ò': "o", 'ô': "o", 'ö': "o", 'õ': "o", 'ø': "o",
+	'ū': "u", 'ú': "u", 'ù': "u", 'û': "u", 'ü': "u",
+	'ç': "c", 'ñ': "n", 'ß': "ss", 'æ': "ae", 'œ': "oe",
+}
+
+// romaji сводит к одному написанию слоги, которые по Хепбёрну и по
+// Поливанову (после транслитерации) пишутся по-разному: shi/си → si,
+// chi/ти → ti, ji/дзи → zi, а также m перед губными (sempai/senpai).
+// При совпадении на одной позиции выбирается замена, указанная раньше.
+var romaji = strings.NewReplacer(
+	"shch", "sy",
+	"sh", "sy",
+	"ch", "ty",
+	"zh", "zy",
+	"dz", "z",
+	"j", "zy",
+	"kh", "h",
+	"w", "v",
+	"x", "ks",
+	"mb", "nb",
+	"mp", "np",
+)
+
+// vowels убирает смягчение перед i и e, появившееся после romaji
+// (syi → si), и схлопывает долгие гласные: «Kyouko», «Kyooko» и
+// «Kyoko» дают один ключ.
+var vowels = strings.NewReplacer(
+	"yi", "i",
+	"ye", "e",
+	"ou", "o",
+	"oo", "o",
+	"uu", "u",
+	"aa", "a",
+	"ee", "e",
+	"ii", "i",
+)
+
+// Tokens разбивает название на слова и приводит каждое к ключу поиска:
+// нижний регистр, кириллица в латиницу, без диакритики, с единым
+// написанием ромадзи. Слова других письменностей (кандзи, кана)
+// остаются как есть.
+func Tokens(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make([]string, 0, len(words))
+	for _, word := range words {
+		if key := fold(word); key != "" {
+			tokens = append(tokens, key)
+		}
+	}
+	return tokens
+}
+
+func fold(word string) string {
+	var sb strings.Builder
+	for _, r := range word {
+		if latin, ok := cyrillic[r]; ok {
+			sb.WriteString(latin)
+		} else if latin, ok := diacritics[r]; ok {
+			sb.WriteString(latin)
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	return vowels.Replace(romaji.Replace(sb.String()))
+}
+
+// typoLimit возвращает, сколько опечаток допускается в слове запроса:
+// в коротких словах ни одной, иначе одна на каждые четыре буквы, но не
+// больше двух.
+func typoLimit(word []rune) int {
+	if len(word) < 4 {
+		return 0
+	}
+	return min(2, len(word)/4)
+}
+
+// distance возвращает расстояние Дамерау — Левенштейна между a и b
+// (перестановка соседних букв считается одной опечаткой) или limit+1,
+// если оно больше limit.
+func distance(a, b []rune, limit int) int {
+	if abs(len(a)-len(b)) > limit {
+		return limit + 1
+	}
+
+	// Три строки матрицы: предыдущая нужна для перестановок.
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		rowMin := cur[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+			rowMin = min(rowMin, cur[j])
+		}
+		if rowMin > limit {
+			return limit + 1
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return min(prev[len(b)], limit+1)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
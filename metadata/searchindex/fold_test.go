@@ -0,0 +1,63 @@
+package searchindex
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Shingeki no Kyojin", []string{"singeki", "no", "kyozin"}},
+		{"Атака титанов", []string{"ataka", "titanov"}},
+		{"atak titanov", []string{"atak", "titanov"}},
+		// Хепбёрн и Поливанов сводятся к одному ключу.
+		{"Jujutsu Kaisen", []string{"zyuzyutsu", "kaisen"}},
+		{"Дзюдзюцу кайсэн", []string{"zyuzyutsu", "kaisen"}},
+		{"Кёдзин", []string{"kyozin"}},
+		// Макроны и долгие гласные.
+		{"Kyōto", []string{"kyoto"}},
+		{"Kyouko", []string{"kyoko"}},
+		{"Sempai", []string{"senpai"}},
+		{"Steins;Gate 0", []string{"steins", "gate", "0"}},
+		{"進撃の巨人", []string{"進撃の巨人"}},
+		{"", []string{}},
+		{" — ", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := Tokens(tt.in); !slices.Equal(got, tt.want) {
+				t.Errorf("Tokens(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		limit int
+		want  int
+	}{
+		{"titanov", "titanov", 2, 0},
+		{"atak", "ataka", 2, 1},
+		{"kyozyn", "kyozin", 2, 1},
+		// Перестановка соседних букв — одна опечатка.
+		{"titnaov", "titanov", 2, 1},
+		{"naruto", "boruto", 2, 2},
+		{"", "abc", 5, 3},
+		// Больше limit — limit+1, в том числе по разнице длин.
+		{"naruto", "boruto", 1, 2},
+		{"sing", "singeki", 2, 3},
+		{"abcdef", "ghijkl", 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			if got := distance([]rune(tt.a), []rune(tt.b), tt.limit); got != tt.want {
+				t.Errorf("distance(%q, %q, %d) = %d, want %d", tt.a, tt.b, tt.limit, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// Package searchindex — локальный поисковый индекс по названиям тайтлов,
+// которые сервис метаданных уже получал от источников. Он находит тайтл
+// по любому из его названий с опечатками, в кириллице или латинице и в
+// разных системах записи ромадзи: «atak titanov», «Shingeki» и «Атака
+// титанов» ведут к одному сериалу.
+package searchindex
+
+import (
+	"cmp"
+	"container/list"
+	"slices"
+	"strings"
+	"sync"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxCandidates ограничивает число тайтлов с общими триграммами,
+	// которые сравниваются с запросом подробно.
+	maxCandidates = 1000
+	// minScore — наименьшая оценка совпадения, при которой тайтл попадает
+	// в результаты.
+	minScore = 0.5
+)
+
+// Key определяет тайтл: ID у разных источников независимы.
+type Key struct {
+	Provider  string
+	MediaType pb.MediaType
+	ID        int64
+}
+
+// Hit — найденный тайтл и оценка совпадения от 0 до 1.
+type Hit struct {
+	Title *pb.Movie
+	Score float64
+}
+
+type entry struct {
+	key Key
+	// seq — порядковый номер добавления, чтобы кандидаты с одинаковым
+	// числом общих триграмм упорядочивались одинаково.
+	seq uint64
+	// titles — последние карточки тайтла по языку запроса.
+	titles map[string]*pb.Movie
+	latest *pb.Movie
+	// names — ключи всех известных названий: при повторном добавлении
+	// на другом языке они накапливаются.
+	names [][]string
+	grams map[string]bool
+}
+
+// Index хранит до maxTitles тайтлов в памяти. Когда он заполнен, новый
+// тайтл вытесняет тот, который дольше всех не встречался в ответах
+// источников.
+type Index struct {
+	maxTitles int
+
+	mu    sync.RWMutex
+	lru   *list.List // *entry, недавние спереди
+	byKey map[Key]*list.Element
+	// grams — записи по триграммам ключей их названий.
+	grams map[string]map[*entry]struct{}
+	seq   uint64
+}
+
+func New(maxTitles int) *Index {
+	return &Index{
+		maxTitles: maxTitles,
+		lru:       list.New(),
+		byKey:     make(map[Key]*list.Element),
+		grams:     make(map[string]map[*entry]struct{}),
+	}
+}
+
+// Len возвращает число тайтлов в индексе.
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	return ix.lru.Len()
+}
+
+// Add добавляет тайтл источника provider, полученный на языке language,
+// под названием, оригинальным названием и альтернативными названиями.
+// Тайтлы без ID или типа пропускаются.
+func (ix *Index) Add(provider, language string, title *pb.Movie) {
+	if title.GetId() == 0 || title.GetMediaType() == pb.MediaType_MEDIA_TYPE_UNSPECIFIED {
+		return
+	}
+	key := Key{Provider: provider, MediaType: title.GetMediaType(), ID: title.GetId()}
+	names := append([]string{title.GetTitle(), title.GetOriginalTitle()}, title.GetAlternativeTitles()...)
+	title = proto.Clone(title).(*pb.Movie)
+
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	el, ok := ix.byKey[key]
+	if ok {
+		ix.lru.MoveToFront(el)
+	} else {
+		for ix.lru.Len() >= ix.maxTitles && ix.lru.Len() > 0 {
+			ix.remove(ix.lru.Back())
+		}
+		ix.seq++
+		el = ix.lru.PushFront(&entry{key: key, seq: ix.seq, titles: make(map[string]*pb.Movie), grams: make(map[string]bool)})
+		ix.byKey[key] = el
+	}
+
+	e := el.Value.(*entry)
+	e.titles[language] = title
+	e.latest = title
+	for _, name := range names {
+		tokens := Tokens(name)
+		if len(tokens) == 0 || slices.ContainsFunc(e.names, func(known []string) bool {
+			return slices.Equal(known, tokens)
+		}) {
+			continue
+		}
+		e.names = append(e.names, tokens)
+		for _, token := range tokens {
+			for _, g := range trigrams(token) {
+				if !e.grams[g] {
+					e.grams[g] = true
+					if ix.grams[g] == nil {
+						ix.grams[g] = make(map[*entry]struct{})
+					}
+					ix.grams[g][e] = struct{}{}
+				}
+			}
+		}
+	}
+}
+
+// remove убирает запись el из индекса вместе с её триграммами.
+func (ix *Index) remove(el *list.Element) {
+	e := ix.lru.Remove(el).(*entry)
+	delete(ix.byKey, e.key)
+	for g := range e.grams {
+		delete(ix.grams[g], e)
+		if len(ix.grams[g]) == 0 {
+			delete(ix.grams, g)
+		}
+	}
+}
+
+// Search ищет тайтлы источника provider типа mediaType по запросу query
+// и возвращает не больше limit лучших. Карточки отдаются на языке
+// language, если тайтл был получен на нём, иначе на последнем известном.
+func (ix *Index) Search(provider string, mediaType pb.MediaType, query, language string, limit int) []Hit {
+	tokens := Tokens(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+	queryRunes := make([][]rune, len(tokens))
+	for i, t := range tokens {
+		queryRunes[i] = []rune(t)
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	// Кандидаты — тайтлы с наибольшим числом общих с запросом триграмм.
+	shared := make(map[*entry]int)
+	for _, token := range tokens {
+		for _, g := range trigrams(token) {
+			for e := range ix.grams[g] {
+				if e.key.Provider == provider && e.key.MediaType == mediaType {
+					shared[e]++
+				}
+			}
+		}
+	}
+	candidates := make([]*entry, 0, len(shared))
+	for e := range shared {
+		candidates = append(candidates, e)
+	}
+	slices.SortFunc(candidates, func(a, b *entry) int {
+		return cmp.Or(cmp.Compare(shared[b], shared[a]), cmp.Compare(a.seq, b.seq))
+	})
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+
+	var hits []Hit
+	for _, e := range candidates {
+		var score float64
+		for _, name := range e.names {
+			score = max(score, matchName(queryRunes, name))
+		}
+		if score < minScore {
+			continue
+		}
+		title, ok := e.titles[language]
+		if !ok {
+			title = e.latest
+		}
+		hits = append(hits, Hit{Title: proto.Clone(title).(*pb.Movie), Score: score})
+	}
+
+	slices.SortStableFunc(hits, func(a, b Hit) int {
+		return cmp.Or(
+			cmp.Compare(b.Score, a.Score),
+			cmp.Compare(b.Title.GetPopularity(), a.Title.GetPopularity()),
+			cmp.Compare(b.Title.GetVoteAverage(), a.Title.GetVoteAverage()),
+		)
+	})
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
+
+// matchName оценивает, насколько название name подходит под запрос:
+// каждое слово запроса должно совпасть с каким-нибудь словом названия.
+// Названия с лишними словами оцениваются немного ниже.
+func matchName(query [][]rune, name []string) float64 {
+	var total float64
+	for _, q := range query {
+		var best float64
+		for _, word := range name {
+			best = max(best, matchWord(q, word))
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+
+	score := total / float64(len(query))
+	return score * (0.9 + 0.1*float64(len(query))/float64(max(len(query), len(name))))
+}
+
+// matchWord оценивает совпадение слова запроса q со словом названия:
+// полное совпадение, начало слова (запрос ещё набирается) и то же с
+// опечатками.
+func matchWord(q []rune, word string) float64 {
+	s := string(q)
+	switch {
+	case s == word:
+		return 1
+	case len(q) >= 3 && strings.HasPrefix(word, s):
+		return 0.9
+	}
+
+	limit := typoLimit(q)
+	if limit == 0 {
+		return 0
+	}
+	w := []rune(word)
+	if d := distance(q, w, limit); d <= limit {
+		return 0.85 - 0.15*float64(d-1)
+	}
+	if len(w) > len(q) {
+		if d := distance(q, w[:len(q)], limit); d <= limit {
+			return 0.75 - 0.15*float64(d-1)
+		}
+	}
+	return 0
+}
+
+// trigrams возвращает триграммы слова с отметками начала и конца, так
+// что и у слов из одной-двух букв есть хотя бы одна.
+func trigrams(word string) []string {
+	runes := []rune("^" + word + "$")
+	grams := make([]string, 0, max(1, len(runes)-2))
+	for i := 0; i+3 <= len(runes); i++ {
+		grams = append(grams, string(runes[i:i+3]))
+	}
+	if len(grams) == 0 {
+		grams = append(grams, string(runes))
+	}
+	return grams
+}
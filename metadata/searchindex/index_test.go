@@ -0,0 +1,150 @@
+package searchindex
+
+import (
+	"slices"
+	"testing"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+func TestMatchWord(t *testing.T) {
+	tests := []struct {
+		query, word string
+		want        float64
+	}{
+		{"titanov", "titanov", 1},
+		// Запрос ещё набирается.
+		{"atak", "ataka", 0.9},
+		{"sing", "singeki", 0.9},
+		// Опечатки: в полном слове и в его начале.
+		{"titnaov", "titanov", 0.85},
+		{"sgingeki", "singeki", 0.85},
+		{"sibgek", "singekino", 0.75},
+		// Короткие слова должны совпасть точно или началом.
+		{"abc", "abd", 0},
+		{"no", "nox", 0},
+		{"naruto", "boruto", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query+"/"+tt.word, func(t *testing.T) {
+			if got := matchWord([]rune(tt.query), tt.word); got != tt.want {
+				t.Errorf("matchWord(%q, %q) = %v, want %v", tt.query, tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearch(t *testing.T) {
+	const tv = pb.MediaType_MEDIA_TYPE_TV
+
+	ix := New(100)
+	ix.Add("tmdb", "en", &pb.Movie{
+		Id: 1429, MediaType: tv, Popularity: 100,
+		Title: "Attack on Titan", OriginalTitle: "Shingeki no Kyojin",
+	})
+	ix.Add("tmdb", "ru", &pb.Movie{Id: 1429, MediaType: tv, Popularity: 100, Title: "Атака титанов"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 95479, MediaType: tv, Popularity: 80, Title: "Jujutsu Kaisen"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 1430, MediaType: tv, Popularity: 10, Title: "Attack on Titan: Junior High"})
+	ix.Add("anilist", "en", &pb.Movie{Id: 16498, MediaType: tv, Title: "Shingeki no Kyojin"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 1429, MediaType: pb.MediaType_MEDIA_TYPE_MOVIE, Title: "Shingeki no Kyojin"})
+
+	tests := []struct {
+		name      string
+		provider  string
+		mediaType pb.MediaType
+		query     string
+		language  string
+		want      []int64
+		wantTitle string
+	}{
+		{
+			name: "недописанный транслит", provider: "tmdb", mediaType: tv,
+			query: "atak titanov", language: "ru",
+			want: []int64{1429}, wantTitle: "Атака титанов",
+		},
+		{
+			name: "начало ромадзи", provider: "tmdb", mediaType: tv,
+			query: "Shingeki", language: "en",
+			want: []int64{1429}, wantTitle: "Attack on Titan",
+		},
+		{
+			name: "кириллица", provider: "tmdb", mediaType: tv,
+			query: "Атака титанов", language: "ja",
+			want: []int64{1429},
+		},
+		{
+			name: "Поливанов находит Хепбёрна", provider: "tmdb", mediaType: tv,
+			query: "дзюдзюцу кайсэн",
+			want:  []int64{95479},
+		},
+		{
+			name: "название с лишними словами ниже", provider: "tmdb", mediaType: tv,
+			query: "attack on titan",
+			want:  []int64{1429, 1430},
+		},
+		{
+			name: "тайтлы другого источника не находятся", provider: "anilist", mediaType: tv,
+			query: "Shingeki",
+			want:  []int64{16498},
+		},
+		{
+			name: "тайтлы другого типа не находятся", provider: "tmdb", mediaType: pb.MediaType_MEDIA_TYPE_MOVIE,
+			query: "Атака титанов",
+		},
+		{
+			name: "нет совпадений", provider: "tmdb", mediaType: tv,
+			query: "naruto",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hits := ix.Search(tt.provider, tt.mediaType, tt.query, tt.language, 10)
+			var got []int64
+			for _, hit := range hits {
+				got = append(got, hit.Title.GetId())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("Search(%q) = %v, want %v", tt.query, got, tt.want)
+			}
+			if tt.wantTitle != "" && hits[0].Title.GetTitle() != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", hits[0].Title.GetTitle(), tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestAddLimit(t *testing.T) {
+	const tv = pb.MediaType_MEDIA_TYPE_TV
+
+	ix := New(2)
+	ix.Add("tmdb", "en", &pb.Movie{Id: 1, MediaType: tv, Title: "Naruto"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 2, MediaType: tv, Title: "Bleach"})
+	// Наруто снова в ответе, поэтому вытесняется Блич.
+	ix.Add("tmdb", "ru", &pb.Movie{Id: 1, MediaType: tv, Title: "Наруто"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 3, MediaType: tv, Title: "One Piece"})
+	ix.Add("tmdb", "en", &pb.Movie{Id: 4, Title: "без типа"})
+
+	if ix.Len() != 2 {
+		t.Fatalf("Len = %d, want 2", ix.Len())
+	}
+	if hits := ix.Search("tmdb", tv, "Bleach", "en", 10); len(hits) != 0 {
+		t.Errorf("вытесненный тайтл найден: %v", hits)
+	}
+	if hits := ix.Search("tmdb", tv, "наруто", "ru", 10); len(hits) != 1 {
+		t.Errorf("недавно обновлённый тайтл вытеснен: %v", hits)
+	}
+	if hits := ix.Search("tmdb", tv, "One Piece", "en", 10); len(hits) != 1 {
+		t.Errorf("новый тайтл не добавлен: %v", hits)
+	}
+
+	// Вытесненный тайтл не оставляет триграмм.
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+	for g, entries := range ix.grams {
+		for e := range entries {
+			if _, ok := ix.byKey[e.key]; !ok {
+				t.Errorf("триграмма %q ссылается на вытесненный тайтл %v", g, e.key)
+			}
+		}
+	}
+}
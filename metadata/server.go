@@ -2,101 +2,78 @@ package metadata
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"log"
-	"net/url"
+	"time"
 
+	"github.com/waste3d/Hikari-Anime/metadata/cache"
+	"github.com/waste3d/Hikari-Anime/metadata/catalog"
+	"github.com/waste3d/Hikari-Anime/metadata/idmap"
+	"github.com/waste3d/Hikari-Anime/metadata/images"
 	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
-	"github.com/waste3d/Hikari-Anime/metadata/utils"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"github.com/waste3d/Hikari-Anime/metadata/searchindex"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-type TMDbPopularResponse struct {
-	Page       int         `json:"page"`
-	Results    []TMDbMovie `json:"results"`
-	TotalPages int         `json:"total_pages"`
-}
+type Server struct {
+	pb.UnimplementedMetadataServiceServer
 
-type TMDbMovie struct {
-	ID            int64   `json:"id"`
-	Title         string  `json:"title"`
-	OriginalTitle string  `json:"original_title"`
-	Overview      string  `json:"overview"`
-	PosterPath    string  `json:"poster_path"`
-	ReleaseDate   string  `json:"release_date"`
-	VoteAverage   float64 `json:"vote_average"`
-}
+	provider  provider.Provider
+	providers map[string]provider.Provider
+	cache     *cache.Cache
+	ids       *idmap.Mapping
 
-type TMDbTVShowSearchResponse struct {
-	Page       int          `json:"page"`
-	Results    []TMDbTVShow `json:"results"`
-	TotalPages int          `json:"total_pages"`
-}
+	catalog         *catalog.Store
+	catalogFreshFor time.Duration
 
-type TMDbTVShow struct {
-	ID           int64   `json:"id"`
-	Name         string  `json:"name"`
-	OriginalName string  `json:"original_name"`
-	Overview     string  `json:"overview"`
-	PosterPath   string  `json:"poster_path"`
-	FirstAirDate string  `json:"first_air_date"`
-	VoteAverage  float64 `json:"vote_average"`
+	fallbackLanguages []string
+	images            *images.Builder
+	index             *searchindex.Index
 }
 
-const (
-	tmdbAPIKey  = "59a65ec73d0fbe5eca1f931db3031d3f"
-	tmdbBaseURL = "https://api.themoviedb.org/3"
-)
+// Option настраивает Server при создании.
+type Option func(*Server)
 
-type Server struct {
-	pb.UnimplementedMetadataServiceServer
-}
-
-func NewServer() *Server {
-	return &Server{}
+// WithCache включает кэширование ответов через CacheInterceptor.
+func WithCache(c *cache.Cache) Option {
+	return func(s *Server) {
+		s.cache = c
+	}
 }
 
-func (s *Server) GetPopularMovies(ctx context.Context, req *pb.GetPopularMoviesRequest) (*pb.GetPopularMoviesResponse, error) {
-	url := fmt.Sprintf("%s/movie/popular?api_key=%s&language=%s&page=%d",
-		tmdbBaseURL, tmdbAPIKey, req.GetLanguage(), req.GetPage())
-	log.Printf("Выполняю запрос к TMDb по URL: %s", url)
-
-	resp, err := utils.GetRequest(url)
-	if err != nil {
-		return nil, err
+// WithCatalog включает постоянный каталог через CatalogInterceptor.
+// Ответы моложе freshFor отдаются из каталога без обращения к источнику.
+func WithCatalog(store *catalog.Store, freshFor time.Duration) Option {
+	return func(s *Server) {
+		s.catalog = store
+		s.catalogFreshFor = freshFor
 	}
-	defer resp.Body.Close()
+}
 
-	var tmdbResponse TMDbPopularResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResponse); err != nil {
-		log.Printf("ОШИБКА при декодировании JSON: %v", err)
-		return nil, fmt.Errorf("ошибка при декодировании JSON: %w", err)
+func NewServer(p provider.Provider, opts ...Option) *Server {
+	s := &Server{
+		provider:  p,
+		providers: map[string]provider.Provider{p.Name(): p},
+		ids:       idmap.New(),
+		images:    images.New(nil, []string{images.Original}, ""),
 	}
-
-	log.Printf("Получено %d фильмов от TMDb", len(tmdbResponse.Results))
-
-	var movies []*pb.Movie
-	for _, movie := range tmdbResponse.Results {
-		movies = append(movies, &pb.Movie{
-			Id:            movie.ID,
-			Title:         movie.Title,
-			OriginalTitle: movie.OriginalTitle,
-			PosterPath:    "https://image.tmdb.org/t/p/w500" + movie.PosterPath,
-			Overview:      movie.Overview,
-			ReleaseDate:   movie.ReleaseDate,
-			VoteAverage:   movie.VoteAverage,
-		})
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
+}
 
-	response := &pb.GetPopularMoviesResponse{
-		Results:    movies,
-		Page:       int32(tmdbResponse.Page),
-		TotalPages: int32(tmdbResponse.TotalPages),
+func (s *Server) GetPopularMovies(ctx context.Context, req *pb.GetPopularMoviesRequest) (*pb.GetPopularMoviesResponse, error) {
+	page, err := s.providerFor(ctx).PopularMovies(ctx, req.GetPage(), req.GetLanguage())
+	if err != nil {
+		return nil, err
 	}
 
-	return response, nil
+	return &pb.GetPopularMoviesResponse{
+		Results:    page.Results,
+		Page:       page.Page,
+		TotalPages: page.TotalPages,
+	}, nil
 }
 
 func (s *Server) SearchMovies(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
@@ -105,80 +82,38 @@ func (s *Server) SearchMovies(ctx context.Context, req *pb.SearchRequest) (*pb.S
 		return nil, status.Errorf(codes.InvalidArgument, "поисковый запрос (query) не может быть пустым")
 	}
 
-	encodedQuery := url.QueryEscape(query)
-
-	url := fmt.Sprintf("%s/search/movie?api_key=%s&language=%s&query=%s&page=%d",
-		tmdbBaseURL, tmdbAPIKey, req.GetLanguage(), encodedQuery, req.GetPage())
-	log.Printf("Выполняю запрос к TMDb по URL: %s", url)
-
-	resp, err := utils.GetRequest(url)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
-
-	var tmdbResponse TMDbPopularResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResponse); err != nil {
-		log.Printf("ОШИБКА при декодировании JSON: %v", err)
-		return nil, fmt.Errorf("ошибка при декодировании JSON: %w", err)
+	var resp *pb.SearchResponse
+	page, err := s.providerFor(ctx).SearchMovies(ctx, query, req.GetPage(), req.GetLanguage())
+	if err == nil {
+		resp = &pb.SearchResponse{
+			Results:    page.Results,
+			Page:       page.Page,
+			TotalPages: page.TotalPages,
+		}
 	}
-
-	log.Printf("Найдено %d фильмов от TMDb", len(tmdbResponse.Results))
-
-	var movies []*pb.Movie
-	for _, movie := range tmdbResponse.Results {
-		movies = append(movies, &pb.Movie{
-			Id:            movie.ID,
-			Title:         movie.Title,
-			OriginalTitle: movie.OriginalTitle,
-			PosterPath:    "https://image.tmdb.org/t/p/w500" + movie.PosterPath,
-			Overview:      movie.Overview,
-			ReleaseDate:   movie.ReleaseDate,
-			VoteAverage:   movie.VoteAverage,
-		})
-	}
-
-	response := &pb.SearchResponse{
-		Results:    movies,
-		Page:       int32(tmdbResponse.Page),
-		TotalPages: int32(tmdbResponse.TotalPages),
-	}
-
-	return response, nil
+	return s.withLocalResults(ctx, pb.MediaType_MEDIA_TYPE_MOVIE, req, resp, err)
 }
 
-func (s *Server) GetMovieByID(ctx context.Context, req *pb.GetMovieByIDRequest) (*pb.Movie, error) {
-	movieID := req.GetMovieId()
+func (s *Server) GetMovieByID(ctx context.Context, req *pb.GetMovieByIDRequest) (*pb.TitleDetails, error) {
+	p, movieID := s.providerFor(ctx), req.GetMovieId()
+	if req.GetId() != "" {
+		var err error
+		if p, movieID, err = s.resolveID(ctx, req.GetId(), pb.MediaType_MEDIA_TYPE_MOVIE); err != nil {
+			return nil, err
+		}
+	}
 	if movieID == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "ID фильма (movie_id) не может быть равен 0")
 	}
 
-	url := fmt.Sprintf("%s/movie/%d?api_key=%s&language=%s",
-		tmdbBaseURL, movieID, tmdbAPIKey, req.GetLanguage())
-	log.Printf("Выполняю запрос к TMDb по URL: %s", url)
-
-	resp, err := utils.GetRequest(url)
+	details, err := p.MovieByID(ctx, movieID, req.GetLanguage())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	var tmdbResponse TMDbMovie
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResponse); err != nil {
-		log.Printf("ОШИБКА при декодировании JSON: %v", err)
-		return nil, fmt.Errorf("ошибка при декодировании ответа от TMDb: %w", err)
-	}
-
-	log.Printf("Получен фильм от TMDb: %s", tmdbResponse.Title)
-	return &pb.Movie{
-		Id:            tmdbResponse.ID,
-		Title:         tmdbResponse.Title,
-		OriginalTitle: tmdbResponse.OriginalTitle,
-		PosterPath:    "https://image.tmdb.org/t/p/w500" + tmdbResponse.PosterPath,
-		Overview:      tmdbResponse.Overview,
-		VoteAverage:   tmdbResponse.VoteAverage,
-		ReleaseDate:   tmdbResponse.ReleaseDate,
-	}, nil
+	s.localize(ctx, details, req.GetLanguage(), func(ctx context.Context, language string) (*pb.TitleDetails, error) {
+		return p.MovieByID(ctx, movieID, language)
+	})
+	return s.withExternalIDs(details), nil
 }
 
 func (s *Server) SearchTVShows(ctx context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
@@ -189,43 +124,63 @@ func (s *Server) SearchTVShows(ctx context.Context, req *pb.SearchRequest) (*pb.
 		return nil, status.Errorf(codes.InvalidArgument, "поисковый запрос (query) не может быть пустым")
 	}
 
-	encodedQuery := url.QueryEscape(query)
+	var resp *pb.SearchResponse
+	result, err := s.providerFor(ctx).SearchTVShows(ctx, query, page, language)
+	if err == nil {
+		resp = &pb.SearchResponse{
+			Results:    result.Results,
+			Page:       result.Page,
+			TotalPages: result.TotalPages,
+		}
+	}
+	return s.withLocalResults(ctx, pb.MediaType_MEDIA_TYPE_TV, req, resp, err)
+}
 
-	url := fmt.Sprintf("%s/search/tv?api_key=%s&language=%s&query=%s&page=%d",
-		tmdbBaseURL, tmdbAPIKey, req.GetLanguage(), encodedQuery, req.GetPage())
-	log.Printf("Выполняю запрос к TMDb по URL: %s", url)
+func (s *Server) GetTVShowByID(ctx context.Context, req *pb.GetTVShowByIDRequest) (*pb.TitleDetails, error) {
+	p, tvID := s.providerFor(ctx), req.GetTvId()
+	if req.GetId() != "" {
+		var err error
+		if p, tvID, err = s.resolveID(ctx, req.GetId(), pb.MediaType_MEDIA_TYPE_TV); err != nil {
+			return nil, err
+		}
+	}
+	if tvID == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID сериала (tv_id) не может быть равен 0")
+	}
+	loc, err := location(req.GetTimezone())
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := utils.GetRequest(url)
+	details, err := p.TVShowByID(ctx, tvID, req.GetLanguage())
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	s.localize(ctx, details, req.GetLanguage(), func(ctx context.Context, language string) (*pb.TitleDetails, error) {
+		return p.TVShowByID(ctx, tvID, language)
+	})
+	setAirsAt(details.GetNextEpisode(), loc)
+	return s.withExternalIDs(details), nil
+}
 
-	var tmdbResponse TMDbTVShowSearchResponse
-	if err := json.NewDecoder(resp.Body).Decode(&tmdbResponse); err != nil {
-		return nil, fmt.Errorf("ошибка при декодировании JSON: %w", err)
+func (s *Server) GetSeason(ctx context.Context, req *pb.GetSeasonRequest) (*pb.Season, error) {
+	if req.GetTvId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID сериала (tv_id) не может быть равен 0")
+	}
+	if req.GetSeasonNumber() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "номер сезона (season_number) не может быть отрицательным")
 	}
 
-	log.Printf("Найдено %d сериалов от TMDb", len(tmdbResponse.Results))
+	return s.providerFor(ctx).Season(ctx, req.GetTvId(), req.GetSeasonNumber(), req.GetLanguage())
+}
 
-	var tvShows []*pb.Movie
-	for _, tvShow := range tmdbResponse.Results {
-		tvShows = append(tvShows, &pb.Movie{
-			Id:            tvShow.ID,
-			Title:         tvShow.Name,
-			OriginalTitle: tvShow.OriginalName,
-			PosterPath:    "https://image.tmdb.org/t/p/w500" + tvShow.PosterPath,
-			Overview:      tvShow.Overview,
-			ReleaseDate:   tvShow.FirstAirDate,
-			VoteAverage:   tvShow.VoteAverage,
-		})
+func (s *Server) GetEpisode(ctx context.Context, req *pb.GetEpisodeRequest) (*pb.Episode, error) {
+	if req.GetTvId() == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "ID сериала (tv_id) не может быть равен 0")
 	}
-
-	response := &pb.SearchResponse{
-		Results:    tvShows,
-		Page:       int32(tmdbResponse.Page),
-		TotalPages: int32(tmdbResponse.TotalPages),
+	if req.GetSeasonNumber() < 0 || req.GetEpisodeNumber() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "некорректный номер сезона или эпизода")
 	}
 
-	return response, nil
+	return s.providerFor(ctx).Episode(ctx, req.GetTvId(), req.GetSeasonNumber(), req.GetEpisodeNumber(), req.GetLanguage())
 }
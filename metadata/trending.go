@@ -0,0 +1,59 @@
+package metadata
+
+import (
+	"context"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+	"github.com/waste3d/Hikari-Anime/metadata/provider"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetTrending(ctx context.Context, req *pb.GetTrendingRequest) (*pb.TrendingResponse, error) {
+	switch req.GetMediaType() {
+	case pb.MediaType_MEDIA_TYPE_UNSPECIFIED, pb.MediaType_MEDIA_TYPE_MOVIE, pb.MediaType_MEDIA_TYPE_TV:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "media_type должен быть фильмом, сериалом или не задан")
+	}
+
+	page, err := s.providerFor(ctx).Trending(ctx, req.GetMediaType(), req.GetTimeWindow(), max(req.GetPage(), 1), req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TrendingResponse{
+		Results:      page.Results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}, nil
+}
+
+func (s *Server) GetRecommendations(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.RecommendationsResponse, error) {
+	return s.relatedTitles(ctx, req, provider.Provider.Recommendations)
+}
+
+func (s *Server) GetSimilar(ctx context.Context, req *pb.GetRecommendationsRequest) (*pb.RecommendationsResponse, error) {
+	return s.relatedTitles(ctx, req, provider.Provider.Similar)
+}
+
+// relatedTitles выполняет GetRecommendations и GetSimilar, которые
+// отличаются только методом источника.
+func (s *Server) relatedTitles(ctx context.Context, req *pb.GetRecommendationsRequest,
+	list func(provider.Provider, context.Context, pb.MediaType, int64, int32, string) (*provider.MoviePage, error),
+) (*pb.RecommendationsResponse, error) {
+	p, id, err := s.titleProvider(ctx, req.GetMediaType(), req.GetTitleId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := list(p, ctx, req.GetMediaType(), id, max(req.GetPage(), 1), req.GetLanguage())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RecommendationsResponse{
+		Results:      page.Results,
+		Page:         page.Page,
+		TotalPages:   page.TotalPages,
+		TotalResults: page.TotalResults,
+	}, nil
+}
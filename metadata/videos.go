@@ -0,0 +1,80 @@
+package metadata
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	pb "github.com/waste3d/Hikari-Anime/metadata/proto"
+)
+
+// videoFallbackLanguages — языки, на которых ищутся видео, если на
+// запрошенном их нет: у аниме обычно есть английские трейлеры или хотя
+// бы японские промо-ролики.
+var videoFallbackLanguages = []string{"en-US", "ja-JP"}
+
+func (s *Server) GetVideos(ctx context.Context, req *pb.GetVideosRequest) (*pb.VideosResponse, error) {
+	p, id, err := s.titleProvider(ctx, req.GetMediaType(), req.GetTitleId(), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, language := range videoLanguages(req.GetLanguage()) {
+		videos, err := p.Videos(ctx, req.GetMediaType(), id, language)
+		if err != nil {
+			return nil, err
+		}
+		if len(videos) > 0 {
+			sortVideos(videos)
+			return &pb.VideosResponse{Results: videos, Language: language}, nil
+		}
+	}
+	return &pb.VideosResponse{Language: req.GetLanguage()}, nil
+}
+
+// videoLanguages возвращает запрошенный язык и запасные языки, которые
+// от него отличаются. Видео различаются только языком без региона, поэтому
+// "en-GB" и "en-US" считаются одним языком.
+func videoLanguages(requested string) []string {
+	languages := []string{requested}
+	for _, fallback := range videoFallbackLanguages {
+		if !slices.ContainsFunc(languages, func(l string) bool { return baseLanguage(l) == baseLanguage(fallback) }) {
+			languages = append(languages, fallback)
+		}
+	}
+	return languages
+}
+
+func baseLanguage(language string) string {
+	base, _, _ := strings.Cut(language, "-")
+	return strings.ToLower(base)
+}
+
+// videoTypeRank задаёт порядок видов видео в ответе; остальные виды идут
+// после перечисленных.
+var videoTypeRank = map[pb.VideoType]int{
+	pb.VideoType_VIDEO_TYPE_TRAILER: 1,
+	pb.VideoType_VIDEO_TYPE_TEASER:  2,
+	pb.VideoType_VIDEO_TYPE_OPENING: 3,
+}
+
+// sortVideos ставит первыми официальные видео, среди них — трейлеры,
+// тизеры и опенинги, а внутри вида — более новые.
+func sortVideos(videos []*pb.Video) {
+	rank := func(v *pb.Video) int {
+		return cmp.Or(videoTypeRank[v.GetType()], len(videoTypeRank)+1)
+	}
+	slices.SortStableFunc(videos, func(a, b *pb.Video) int {
+		if a.GetOfficial() != b.GetOfficial() {
+			if a.GetOfficial() {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Or(
+			cmp.Compare(rank(a), rank(b)),
+			strings.Compare(b.GetPublishedAt(), a.GetPublishedAt()),
+		)
+	})
+}